@@ -16,6 +16,15 @@ type Config struct {
 	ApplicationSecret string
 	ConsumerKey       string
 	OVHClient         *ovh.Client
+
+	// IpLoadbalancingAutoRefresh applies the pending changes of an IP load
+	// balancing after each change made on it by a resource
+	IpLoadbalancingAutoRefresh bool
+	ipLoadbalancingRefreshes   *ipLoadbalancingRefreshes
+
+	// route actions and rules available on the IP load balancing services,
+	// to validate routes at plan time
+	ipLoadbalancingAvailableRoutes *ipLoadbalancingAvailableRoutes
 }
 
 type OvhAuthCurrentCredential struct {
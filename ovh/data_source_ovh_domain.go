@@ -0,0 +1,161 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDomain() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDomainRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The domain name registered at OVH",
+				Required:    true,
+			},
+
+			// Computed
+			"dnssec_supported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is DNSSEC supported by this domain",
+			},
+			"glue_record_ipv6_supported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Are IPv6 glue records supported by this domain",
+			},
+			"glue_record_multi_ip_supported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Are glue records with multiple IPs supported by this domain",
+			},
+			"last_update": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update date of the domain",
+			},
+			"name_server_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name servers type: hosted or external",
+			},
+			"offer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Domain offer",
+			},
+			"owo_supported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is whois obfuscation supported by this domain",
+			},
+			"owo_fields": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Whois fields currently obfuscated: address, email or phone",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"transfer_lock_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Transfer lock status: locked, locking, unavailable, unlocked or unlocking",
+			},
+			"whois_owner": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Contact id of the domain owner",
+			},
+			"creation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the domain",
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the domain",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Service status of the domain",
+			},
+			"renewal_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Renewal type of the domain",
+			},
+			"automatic_renew": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the domain automatically renewed",
+			},
+			"delete_at_expiration": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Will the domain be deleted at expiration",
+			},
+		},
+	}
+}
+
+func dataSourceDomainRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	name := d.Get("name").(string)
+
+	ds, err := getDomain(name, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(ds.Domain)
+	d.Set("dnssec_supported", ds.DnssecSupported)
+	d.Set("glue_record_ipv6_supported", ds.GlueRecordIpv6Supported)
+	d.Set("glue_record_multi_ip_supported", ds.GlueRecordMultiIpSupported)
+	d.Set("last_update", ds.LastUpdate)
+	d.Set("name_server_type", ds.NameServerType)
+	d.Set("offer", ds.Offer)
+	d.Set("owo_supported", ds.OwoSupported)
+	d.Set("transfer_lock_status", ds.TransferLockStatus)
+	d.Set("whois_owner", ds.WhoisOwner)
+
+	owoFields := []string{}
+	if ds.OwoSupported {
+		endpoint := fmt.Sprintf(
+			"/domain/%s/owo",
+			url.PathEscape(name),
+		)
+
+		if err := config.OVHClient.Get(endpoint, &owoFields); err != nil {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+		sort.Strings(owoFields)
+	}
+	d.Set("owo_fields", owoFields)
+
+	serviceInfos := &ServiceInfos{}
+	endpoint := fmt.Sprintf(
+		"/domain/%s/serviceInfos",
+		url.PathEscape(name),
+	)
+
+	if err := config.OVHClient.Get(endpoint, serviceInfos); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("creation", serviceInfos.Creation)
+	d.Set("expiration", serviceInfos.Expiration)
+	d.Set("status", serviceInfos.Status)
+	d.Set("renewal_type", serviceInfos.RenewalType)
+	if serviceInfos.Renew != nil {
+		d.Set("automatic_renew", serviceInfos.Renew.Automatic)
+		d.Set("delete_at_expiration", serviceInfos.Renew.DeleteAtExpiration)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainDataSource_basic(t *testing.T) {
+	domain := os.Getenv("OVH_DOMAIN")
+	config := fmt.Sprintf(testAccDomainDatasourceConfig_Basic, domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomainName(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_domain.domain", "id", domain),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_domain.domain", "expiration"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_domain.domain", "name_server_type"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_domain.domain", "transfer_lock_status"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_domain.domain", "owo_fields.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDomainDatasourceConfig_Basic = `
+data "ovh_domain" "domain" {
+  name = "%s"
+}
+`
@@ -34,6 +34,46 @@ func dataSourceDomainZone() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"soa": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expire": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"nx_domain_ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"refresh": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"retry": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"serial": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"server": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -62,5 +102,14 @@ func dataSourceDomainZoneRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("last_update", dz.LastUpdate)
 	d.Set("name_servers", dz.NameServers)
 
+	soa, err := getDomainZoneSoa(zoneName, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("soa", []map[string]interface{}{soa.ToMap()}); err != nil {
+		return fmt.Errorf("Error setting soa of zone %s: %s", zoneName, err)
+	}
+
 	return nil
 }
@@ -0,0 +1,130 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+func dataSourceDomainZoneHistory() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDomainZoneHistoryRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the domain zone",
+				Required:    true,
+			},
+			"creation_date_from": {
+				Type:        schema.TypeString,
+				Description: "Only list versions created after this RFC3339 date",
+				Optional:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateRFC3339Date(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"creation_date_to": {
+				Type:        schema.TypeString,
+				Description: "Only list versions created before this RFC3339 date",
+				Optional:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateRFC3339Date(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"versions": {
+				Type:        schema.TypeList,
+				Description: "Versions of the zone, most recent first",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation date of the version",
+						},
+						"zone_file_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL of the zone file of the version",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainZoneHistoryRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	query := url.Values{}
+	if v, ok := d.GetOk("creation_date_from"); ok {
+		query.Set("creationDate.from", v.(string))
+	}
+	if v, ok := d.GetOk("creation_date_to"); ok {
+		query.Set("creationDate.to", v.(string))
+	}
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/history",
+		url.PathEscape(zone),
+	)
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	dates := []string{}
+	if err := config.OVHClient.Get(endpoint, &dates); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	// dates may not share the same timezone, compare them as times
+	// and fall back to string comparison for unparsable values
+	sort.Slice(dates, func(i, j int) bool {
+		ti, erri := time.Parse(time.RFC3339, dates[i])
+		tj, errj := time.Parse(time.RFC3339, dates[j])
+		if erri != nil || errj != nil {
+			return dates[i] > dates[j]
+		}
+		return ti.After(tj)
+	})
+
+	versions := []map[string]interface{}{}
+	for _, date := range dates {
+		endpoint := fmt.Sprintf(
+			"/domain/zone/%s/history/%s",
+			url.PathEscape(zone),
+			url.PathEscape(date),
+		)
+
+		restorePoint := &DomainZoneRestorePoint{}
+		if err := config.OVHClient.Get(endpoint, restorePoint); err != nil {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+
+		log.Printf("[DEBUG] Found zone %s version %s", zone, restorePoint.CreationDate)
+		versions = append(versions, restorePoint.ToMap())
+	}
+
+	d.SetId(hashcode.Strings(append([]string{zone}, dates...)))
+	d.Set("versions", versions)
+
+	return nil
+}
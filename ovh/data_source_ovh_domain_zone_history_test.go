@@ -0,0 +1,35 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainZoneHistoryDataSource_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneHistoryDatasourceConfig, zone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_domain_zone_history.history", "versions.0.creation_date"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_domain_zone_history.history", "versions.0.zone_file_url"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDomainZoneHistoryDatasourceConfig = `
+data "ovh_domain_zone_history" "history" {
+  zone = "%s"
+}
+`
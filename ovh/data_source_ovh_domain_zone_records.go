@@ -0,0 +1,155 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+func dataSourceDomainZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDomainZoneRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the domain zone",
+				Required:    true,
+			},
+			"fieldtype": {
+				Type:        schema.TypeString,
+				Description: "Filter records on their type (A, CNAME, MX, ...)",
+				Optional:    true,
+			},
+			"subdomain": {
+				Type:          schema.TypeString,
+				Description:   "Filter records on their exact subdomain",
+				Optional:      true,
+				ConflictsWith: []string{"subdomain_regex"},
+			},
+			"subdomain_regex": {
+				Type:          schema.TypeString,
+				Description:   "Filter records on a regular expression matching their subdomain",
+				Optional:      true,
+				ConflictsWith: []string{"subdomain"},
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, err := regexp.Compile(v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%s is not a valid regular expression: %s", k, err))
+					}
+					return
+				},
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Description: "Filter records on their exact target",
+				Optional:    true,
+			},
+
+			// Computed
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "OVH id of the record",
+						},
+						"fieldtype": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the record",
+						},
+						"subdomain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Subdomain of the record",
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Target of the record",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "TTL of the record",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	var subDomainRegex *regexp.Regexp
+	if v, ok := d.GetOk("subdomain_regex"); ok {
+		subDomainRegex = regexp.MustCompile(v.(string))
+	}
+
+	// fieldType and subDomain are filtered by the API,
+	// other filters are applied on the returned records.
+	query := url.Values{}
+	if v, ok := d.GetOk("fieldtype"); ok {
+		query.Set("fieldType", v.(string))
+	}
+	if v, ok := d.GetOk("subdomain"); ok {
+		query.Set("subDomain", v.(string))
+	}
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/record",
+		url.PathEscape(zone),
+	)
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	ids := []int64{}
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	records := []map[string]interface{}{}
+	recordIds := []string{}
+	for _, id := range ids {
+		record, err := ovhDomainZoneRecord(config.OVHClient, zone, strconv.FormatInt(id, 10), false)
+		if err != nil {
+			return err
+		}
+
+		if subDomainRegex != nil && !subDomainRegex.MatchString(record.SubDomain) {
+			continue
+		}
+		if v, ok := d.GetOk("target"); ok && record.Target != v.(string) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Found %s", record)
+		records = append(records, map[string]interface{}{
+			"id":        strconv.FormatInt(record.Id, 10),
+			"fieldtype": record.FieldType,
+			"subdomain": record.SubDomain,
+			"target":    record.Target,
+			"ttl":       record.Ttl,
+		})
+		recordIds = append(recordIds, strconv.FormatInt(record.Id, 10))
+	}
+
+	d.SetId(hashcode.Strings(append([]string{zone}, recordIds...)))
+	d.Set("records", records)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainZoneRecordsDataSource_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE")
+	subdomain := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneRecordsDatasourceConfig, zone, subdomain, subdomain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_domain_zone_records.exact", "records.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.ovh_domain_zone_records.exact", "records.0.id",
+						"ovh_domain_zone_record.record", "id"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_domain_zone_records.regex", "records.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_domain_zone_records.regex", "records.0.target", "192.0.2.20"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDomainZoneRecordsDatasourceConfig = `
+resource "ovh_domain_zone_record" "record" {
+  zone      = "%s"
+  subdomain = "%s"
+  fieldtype = "A"
+  target    = "192.0.2.20"
+}
+
+data "ovh_domain_zone_records" "exact" {
+  zone      = ovh_domain_zone_record.record.zone
+  fieldtype = "A"
+  subdomain = ovh_domain_zone_record.record.subdomain
+}
+
+data "ovh_domain_zone_records" "regex" {
+  zone            = ovh_domain_zone_record.record.zone
+  subdomain_regex = "^%s$"
+  target          = ovh_domain_zone_record.record.target
+}
+`
@@ -24,6 +24,10 @@ func TestAccDomainZoneDataSource_basic(t *testing.T) {
 					testAccCheckDomainZoneHasNameServers("data.ovh_domain_zone.rootzone", t),
 					resource.TestCheckResourceAttr(
 						"data.ovh_domain_zone.rootzone", "id", zoneName),
+					resource.TestCheckResourceAttr(
+						"data.ovh_domain_zone.rootzone", "soa.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_domain_zone.rootzone", "soa.0.serial"),
 				),
 			},
 		},
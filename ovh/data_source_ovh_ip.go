@@ -0,0 +1,102 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func dataSourceIp() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpRead,
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The IP block",
+				Required:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the IP block",
+				Computed:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Type of the IP block",
+				Computed:    true,
+			},
+			"routed_to_service_name": {
+				Type:        schema.TypeString,
+				Description: "Service the IP block is routed to",
+				Computed:    true,
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Description: "Country of the IP block",
+				Computed:    true,
+			},
+			"campus": {
+				Type:        schema.TypeString,
+				Description: "Campus of the IP block",
+				Computed:    true,
+			},
+			"organisation_id": {
+				Type:        schema.TypeString,
+				Description: "RIPE/ARIN organisation of the IP block",
+				Computed:    true,
+			},
+			"can_be_terminated": {
+				Type:        schema.TypeBool,
+				Description: "Whether the IP block can be terminated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIpRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	ip, err := getIp(block, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Read IP block %s", ip)
+
+	d.SetId(ip.Ip)
+	for k, v := range ip.ToMap() {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func getIp(block string, c *ovh.Client) (*Ip, error) {
+	ip := &Ip{}
+	endpoint := fmt.Sprintf(
+		"/ip/%s",
+		url.PathEscape(block),
+	)
+
+	if err := c.Get(endpoint, ip); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return ip, nil
+}
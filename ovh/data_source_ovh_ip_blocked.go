@@ -0,0 +1,181 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func dataSourceIpBlocked() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpBlockedRead,
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The IP block",
+				Required:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"blocked_ips": {
+				Type:        schema.TypeList,
+				Description: "IPs of the block currently blocked for spam or hacking",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"spam": {
+				Type:        schema.TypeList,
+				Description: "IPs of the block detected as spamming",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Description: "The spamming IP",
+							Computed:    true,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Description: "State of the IP: blockedForSpam, unblocking or unblocked",
+							Computed:    true,
+						},
+						"date": {
+							Type:        schema.TypeString,
+							Description: "Date the IP was blocked",
+							Computed:    true,
+						},
+						"time": {
+							Type:        schema.TypeInt,
+							Description: "Duration of the block, in seconds",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"antihack": {
+				Type:        schema.TypeList,
+				Description: "IPs of the block detected as hacking",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Description: "The blocked IP",
+							Computed:    true,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Description: "State of the IP: blocked, unblocking or unblocked",
+							Computed:    true,
+						},
+						"time": {
+							Type:        schema.TypeInt,
+							Description: "Duration of the block, in seconds",
+							Computed:    true,
+						},
+						"logs": {
+							Type:        schema.TypeString,
+							Description: "Logs of the detected attack",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIpBlockedRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+
+	blockedIps := []string{}
+
+	spamIps, err := listIpBlockedIps(ip, "spam", config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	spam := make([]map[string]interface{}, len(spamIps))
+	for i, spamIp := range spamIps {
+		s := &IpSpam{}
+		if err := getIpBlockedIp(ip, "spam", spamIp, s, config.OVHClient); err != nil {
+			return err
+		}
+		if s.State == "blockedForSpam" {
+			blockedIps = append(blockedIps, s.IpSpamming)
+		}
+		spam[i] = s.ToMap()
+	}
+
+	antihackIps, err := listIpBlockedIps(ip, "antihack", config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	antihack := make([]map[string]interface{}, len(antihackIps))
+	for i, antihackIp := range antihackIps {
+		a := &IpAntihack{}
+		if err := getIpBlockedIp(ip, "antihack", antihackIp, a, config.OVHClient); err != nil {
+			return err
+		}
+		if a.State == "blocked" {
+			blockedIps = append(blockedIps, a.IpBlocked)
+		}
+		antihack[i] = a.ToMap()
+	}
+
+	sort.Strings(blockedIps)
+
+	d.SetId(ip)
+	d.Set("blocked_ips", blockedIps)
+	d.Set("spam", spam)
+	d.Set("antihack", antihack)
+
+	return nil
+}
+
+// listIpBlockedIps lists the IPs of a block known to the spam or antihack
+// endpoint, sorted.
+func listIpBlockedIps(ip, kind string, c *ovh.Client) ([]string, error) {
+	endpoint := fmt.Sprintf(
+		"/ip/%s/%s",
+		url.PathEscape(ip),
+		kind,
+	)
+
+	ips := []string{}
+	if err := c.Get(endpoint, &ips); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	sort.Strings(ips)
+	return ips, nil
+}
+
+func getIpBlockedIp(ip, kind, blockedIp string, result interface{}, c *ovh.Client) error {
+	endpoint := fmt.Sprintf(
+		"/ip/%s/%s/%s",
+		url.PathEscape(ip),
+		kind,
+		url.PathEscape(blockedIp),
+	)
+
+	if err := c.Get(endpoint, result); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpBlockedDataSource_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpBlockedDatasourceConfig, block),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_ip_blocked.blocked", "blocked_ips.#"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_ip_blocked.blocked", "spam.#"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_ip_blocked.blocked", "antihack.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIpBlockedDatasourceConfig = `
+data "ovh_ip_blocked" "blocked" {
+  ip = "%s"
+}
+`
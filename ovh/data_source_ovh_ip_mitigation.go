@@ -0,0 +1,185 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func dataSourceIpMitigation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpMitigationRead,
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The IP block",
+				Required:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_on_mitigation": {
+				Type:        schema.TypeString,
+				Description: "The IPv4 of the block on mitigation",
+				Required:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"from": {
+				Type:        schema.TypeString,
+				Description: "Start of the stats period, as a RFC3339 date. Defaults to one hour ago",
+				Optional:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateRFC3339Date(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"to": {
+				Type:        schema.TypeString,
+				Description: "End of the stats period, as a RFC3339 date. Defaults to now",
+				Optional:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateRFC3339Date(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"scale": {
+				Type:        schema.TypeString,
+				Description: "Scale of the stats: 10s, 1m or 5m",
+				Optional:    true,
+				Default:     "1m",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpMitigationStatsScale(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"permanent": {
+				Type:        schema.TypeBool,
+				Description: "Whether the mitigation is permanent",
+				Computed:    true,
+			},
+			"auto": {
+				Type:        schema.TypeBool,
+				Description: "Whether the mitigation was triggered automatically by an attack",
+				Computed:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "State of the mitigation",
+				Computed:    true,
+			},
+			"stats": {
+				Type:        schema.TypeList,
+				Description: "Traffic stats of the mitigation over the period",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestamp": {
+							Type:        schema.TypeInt,
+							Description: "Unix timestamp of the sample",
+							Computed:    true,
+						},
+						"in_bps": {
+							Type:        schema.TypeInt,
+							Description: "Incoming traffic, in bits per second",
+							Computed:    true,
+						},
+						"in_pps": {
+							Type:        schema.TypeInt,
+							Description: "Incoming traffic, in packets per second",
+							Computed:    true,
+						},
+						"out_bps": {
+							Type:        schema.TypeInt,
+							Description: "Outgoing traffic, in bits per second",
+							Computed:    true,
+						},
+						"out_pps": {
+							Type:        schema.TypeInt,
+							Description: "Outgoing traffic, in packets per second",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIpMitigationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipOnMitigation := d.Get("ip_on_mitigation").(string)
+
+	mitigation, err := getIpMitigation(ip, ipOnMitigation, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	if mitigation == nil {
+		return fmt.Errorf("IP %s of block %s is not on mitigation", ipOnMitigation, ip)
+	}
+
+	now := time.Now()
+	from := now.Add(-1 * time.Hour).Format(time.RFC3339)
+	to := now.Format(time.RFC3339)
+	if v, ok := d.GetOk("from"); ok {
+		from = v.(string)
+	}
+	if v, ok := d.GetOk("to"); ok {
+		to = v.(string)
+	}
+
+	query := url.Values{}
+	query.Set("from", from)
+	query.Set("to", to)
+	query.Set("scale", d.Get("scale").(string))
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigation/%s/stats?%s",
+		url.PathEscape(ip),
+		url.PathEscape(ipOnMitigation),
+		query.Encode(),
+	)
+
+	stats := []IpMitigationStats{}
+	if err := config.OVHClient.Get(endpoint, &stats); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	mappedStats := make([]map[string]interface{}, len(stats))
+	for i, s := range stats {
+		mappedStats[i] = s.ToMap()
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", ip, mitigation.IpOnMitigation))
+	d.Set("permanent", mitigation.Permanent)
+	d.Set("auto", mitigation.Auto)
+	d.Set("state", mitigation.State)
+	d.Set("stats", mappedStats)
+
+	return nil
+}
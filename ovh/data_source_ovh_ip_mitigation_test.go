@@ -0,0 +1,49 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpMitigationDataSource_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	ip := os.Getenv("OVH_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpMitigationDataSourceConfig, block, ip),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_ip_mitigation.mitigation", "id", fmt.Sprintf("%s|%s", block, ip)),
+					resource.TestCheckResourceAttr(
+						"data.ovh_ip_mitigation.mitigation", "permanent", "true"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_ip_mitigation.mitigation", "state", "ok"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_ip_mitigation.mitigation", "scale", "5m"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_ip_mitigation.mitigation", "stats.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIpMitigationDataSourceConfig = `
+resource "ovh_ip_mitigation" "mitigation" {
+  ip               = "%s"
+  ip_on_mitigation = "%s"
+}
+
+data "ovh_ip_mitigation" "mitigation" {
+  ip               = ovh_ip_mitigation.mitigation.ip
+  ip_on_mitigation = ovh_ip_mitigation.mitigation.ip_on_mitigation
+  scale            = "5m"
+}
+`
@@ -0,0 +1,69 @@
+package ovh
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func dataSourceIpReverses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpReversesRead,
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The IP block",
+				Required:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"reverses": {
+				Type:        schema.TypeList,
+				Description: "All the reverses of the IP block, sorted by IP",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipreverse": {
+							Type:        schema.TypeString,
+							Description: "The IP of the reverse",
+							Computed:    true,
+						},
+						"reverse": {
+							Type:        schema.TypeString,
+							Description: "The value of the reverse",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIpReversesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+
+	reverses, err := getIpReverses(ip, config.OVHClient)
+	if err != nil {
+		return fmt.Errorf("Error listing reverses of %s:\n\t %q", ip, err)
+	}
+
+	mapped := make([]map[string]interface{}, len(reverses))
+	for i, r := range reverses {
+		mapped[i] = r.ToMap()
+	}
+
+	d.SetId(ip)
+	d.Set("reverses", mapped)
+
+	return nil
+}
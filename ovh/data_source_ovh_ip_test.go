@@ -0,0 +1,37 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpDataSource_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpDatasourceConfig, block),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_ip.ip", "ip", block),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_ip.ip", "type"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_ip.ip", "country"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIpDatasourceConfig = `
+data "ovh_ip" "ip" {
+  ip = "%s"
+}
+`
@@ -0,0 +1,59 @@
+package ovh
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIpLoadbalancingPendingChanges() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpLoadbalancingPendingChangesRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "The internal name of your iploadbalancer.",
+				Required:    true,
+			},
+
+			// Computed
+			"pending_changes": {
+				Type:        schema.TypeList,
+				Description: "Changes waiting for a refresh, per zone",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone": {
+							Type:        schema.TypeString,
+							Description: "Zone of the changes",
+							Computed:    true,
+						},
+						"number": {
+							Type:        schema.TypeInt,
+							Description: "Number of changes waiting for a refresh of the zone",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIpLoadbalancingPendingChangesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	pendings, err := getIpLoadbalancingPendingChanges(serviceName, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	mapped := make([]map[string]interface{}, len(pendings))
+	for i, pending := range pendings {
+		mapped[i] = pending.ToMap()
+	}
+
+	d.SetId(serviceName)
+	d.Set("pending_changes", mapped)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpLoadbalancingPendingChangesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpLoadbalancing(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpLoadbalancingPendingChangesDatasourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ovh_iploadbalancing_pending_changes.changes", "service_name", os.Getenv("OVH_IPLB_SERVICE")),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_iploadbalancing_pending_changes.changes", "pending_changes.#"),
+				),
+			},
+		},
+	})
+}
+
+var testAccIpLoadbalancingPendingChangesDatasourceConfig_basic = fmt.Sprintf(`
+data ovh_iploadbalancing_pending_changes "changes" {
+  service_name = "%s"
+}
+`, os.Getenv("OVH_IPLB_SERVICE"))
@@ -0,0 +1,113 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func dataSourceIpLoadbalancingSsl() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpLoadbalancingSslRead,
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "The internal name of your IP load balancing",
+				Required:    true,
+			},
+			"san": {
+				Type:        schema.TypeString,
+				Description: "A subject alternative name the certificate must cover",
+				Required:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Type of the certificate: built, custom or external",
+				Optional:    true,
+				Computed:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpLoadbalancingSslType(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Human readable name of the certificate",
+				Computed:    true,
+			},
+			"expire_date": {
+				Type:        schema.TypeString,
+				Description: "Expiration date of the certificate",
+				Computed:    true,
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Description: "Fingerprint of the certificate",
+				Computed:    true,
+			},
+			"serial": {
+				Type:        schema.TypeString,
+				Description: "Serial of the certificate",
+				Computed:    true,
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Description: "Subject of the certificate",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIpLoadbalancingSslRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+	san := d.Get("san").(string)
+
+	ssls, err := listIpLoadbalancingSsls(serviceName, d.Get("type").(string), config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	// during a rotation, several certificates may cover the same name:
+	// the one expiring last is returned
+	var found *IpLoadbalancingSsl
+	var foundExpireDate time.Time
+	for _, ssl := range ssls {
+		if !ipLoadbalancingSslCovers(ssl, []string{san}) {
+			continue
+		}
+
+		expireDate, err := time.Parse(time.RFC3339, ssl.ExpireDate)
+		if err != nil {
+			return fmt.Errorf("Could not parse expire date %s of ssl %d: %s", ssl.ExpireDate, ssl.Id, err)
+		}
+
+		if found == nil || expireDate.After(foundExpireDate) {
+			found = ssl
+			foundExpireDate = expireDate
+		}
+	}
+
+	if found == nil {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	d.SetId(fmt.Sprintf("%d", found.Id))
+	for k, v := range found.ToMap() {
+		if k == "san" {
+			continue
+		}
+		d.Set(k, v)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpLoadbalancingSslDataSource_basic(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+	fqdn := fmt.Sprintf("%s.example.com", name)
+	certificate, key := testAccIpLoadbalancingSslSelfSigned(t, fqdn)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckIpLoadbalancingSslPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingSslDatasourceConfig, iplb, certificate, key, name, fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ovh_iploadbalancing_ssl.cert", "id",
+						"ovh_iploadbalancing_ssl.cert", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.ovh_iploadbalancing_ssl.cert", "fingerprint",
+						"ovh_iploadbalancing_ssl.cert", "fingerprint",
+					),
+				),
+			},
+		},
+	})
+}
+
+const testAccIpLoadbalancingSslDatasourceConfig = testAccIpLoadbalancingSslConfig + `
+data "ovh_iploadbalancing_ssl" "cert" {
+  service_name = ovh_iploadbalancing_ssl.cert.service_name
+  san          = "%s"
+  type         = "custom"
+}
+`
@@ -0,0 +1,75 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers/hashcode"
+)
+
+func dataSourceIps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIpsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Filter IP blocks on their type (failover, dedicated, cloud, vps, loadBalancing, ...)",
+				Optional:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpType(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"routed_to_service_name": {
+				Type:        schema.TypeString,
+				Description: "Filter IP blocks on the service they are routed to",
+				Optional:    true,
+			},
+
+			// Computed
+			"result": {
+				Type:        schema.TypeList,
+				Description: "IP blocks matching the filters",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIpsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	query := url.Values{}
+	if v, ok := d.GetOk("type"); ok {
+		query.Set("type", v.(string))
+	}
+	if v, ok := d.GetOk("routed_to_service_name"); ok {
+		query.Set("routedTo.serviceName", v.(string))
+	}
+
+	endpoint := "/ip"
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	ips := []string{}
+	if err := config.OVHClient.Get(endpoint, &ips); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	// sort.Strings sorts in place, returns nothing
+	sort.Strings(ips)
+
+	d.SetId(hashcode.Strings(append([]string{endpoint}, ips...)))
+	d.Set("result", ips)
+	return nil
+}
@@ -0,0 +1,33 @@
+package ovh
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCredentials(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpsDatasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_ips.all", "result.#"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_ips.failover", "result.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIpsDatasourceConfig = `
+data "ovh_ips" "all" {}
+
+data "ovh_ips" "failover" {
+  type = "failover"
+}
+`
@@ -0,0 +1,182 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func waitForDomainTask(domain string, task *DomainTask, c *ovh.Client) error {
+	taskId := task.Id
+
+	refreshFunc := func() (interface{}, string, error) {
+		task, err := getDomainTask(domain, taskId, c)
+		if err != nil {
+			// once done, domain tasks may be purged from the API
+			if err.(*ovh.APIError).Code == 404 {
+				log.Printf("[DEBUG] Task id %d on Domain %s not found, assuming it is done", taskId, domain)
+				return taskId, "done", nil
+			}
+			return taskId, "", err
+		}
+
+		log.Printf("[INFO] Pending Task id %d on Domain %s status: %s", taskId, domain, task.Status)
+		return taskId, task.Status, nil
+	}
+
+	log.Printf("[INFO] Waiting for Domain Task id %s/%d", domain, taskId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"todo", "doing"},
+		Target:     []string{"done"},
+		Refresh:    refreshFunc,
+		Timeout:    30 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Domain task %s/%d to complete: %s", domain, taskId, err)
+	}
+
+	return nil
+}
+
+func getDomainTask(domain string, taskId int64, c *ovh.Client) (*DomainTask, error) {
+	task := &DomainTask{}
+	endpoint := fmt.Sprintf(
+		"/domain/%s/task/%d",
+		url.PathEscape(domain),
+		taskId,
+	)
+
+	if err := c.Get(endpoint, task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// getDomainTaskIds returns the ids of the tasks of the given function on a
+// domain, whatever their status.
+func getDomainTaskIds(domain, function string, c *ovh.Client) ([]int64, error) {
+	ids := []int64{}
+	endpoint := fmt.Sprintf(
+		"/domain/%s/task?function=%s",
+		url.PathEscape(domain),
+		url.QueryEscape(function),
+	)
+
+	if err := c.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return ids, nil
+}
+
+// waitForDomainNewTasks waits for the tasks of the given function created on a
+// domain since knownIds were listed. It is used for operations which trigger a
+// task without returning it, such as a nameServerType update: the task may
+// only be listed some time after the call, so the domain is polled until it
+// shows up, then every new task is waited for.
+func waitForDomainNewTasks(domain, function string, knownIds []int64, c *ovh.Client) error {
+	known := map[int64]bool{}
+	for _, id := range knownIds {
+		known[id] = true
+	}
+
+	refreshFunc := func() (interface{}, string, error) {
+		ids, err := getDomainTaskIds(domain, function, c)
+		if err != nil {
+			return nil, "", err
+		}
+
+		newIds := []int64{}
+		for _, id := range ids {
+			if !known[id] {
+				newIds = append(newIds, id)
+			}
+		}
+
+		if len(newIds) == 0 {
+			log.Printf("[DEBUG] No new %s task on Domain %s yet", function, domain)
+			return newIds, "waiting", nil
+		}
+		return newIds, "created", nil
+	}
+
+	log.Printf("[INFO] Waiting for a new %s task on Domain %s", function, domain)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"waiting"},
+		Target:     []string{"created"},
+		Refresh:    refreshFunc,
+		Timeout:    5 * time.Minute,
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	newIds, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for a %s task on Domain %s: %s", function, domain, err)
+	}
+
+	for _, id := range newIds.([]int64) {
+		if err := waitForDomainTask(domain, &DomainTask{Id: id}, c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func waitForDomainZoneTask(zone string, task *DomainTask, c *ovh.Client) error {
+	taskId := task.Id
+
+	refreshFunc := func() (interface{}, string, error) {
+		task, err := getDomainZoneTask(zone, taskId, c)
+		if err != nil {
+			return taskId, "", err
+		}
+
+		log.Printf("[INFO] Pending Task id %d on Domain zone %s status: %s", taskId, zone, task.Status)
+		return taskId, task.Status, nil
+	}
+
+	log.Printf("[INFO] Waiting for Domain zone Task id %s/%d", zone, taskId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"todo", "doing"},
+		Target:     []string{"done"},
+		Refresh:    refreshFunc,
+		Timeout:    30 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Domain zone task %s/%d to complete: %s", zone, taskId, err)
+	}
+
+	return nil
+}
+
+func getDomainZoneTask(zone string, taskId int64, c *ovh.Client) (*DomainTask, error) {
+	task := &DomainTask{}
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/task/%d",
+		url.PathEscape(zone),
+		taskId,
+	)
+
+	if err := c.Get(endpoint, task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
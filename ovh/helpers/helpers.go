@@ -2,8 +2,13 @@ package helpers
 
 import (
 	"bytes"
+	"crypto/rand"
 	"fmt"
+	"math/big"
 	"net"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ovh/go-ovh/ovh"
@@ -37,6 +42,51 @@ func ValidateIpV4(value string) error {
 	return fmt.Errorf("Value %s is not a valid IPv4", value)
 }
 
+// NormalizeIp returns the canonical spelling of an IP, so that different
+// spellings of the same IPv6 compare equal. Invalid IPs are returned as is.
+func NormalizeIp(value string) string {
+	if ip := net.ParseIP(value); ip != nil {
+		return ip.String()
+	}
+	return value
+}
+
+// NormalizeIpBlock returns the canonical spelling of an IP block.
+// Invalid IP blocks are returned as is.
+func NormalizeIpBlock(value string) string {
+	ip, ipNet, err := net.ParseCIDR(value)
+	if err != nil {
+		return value
+	}
+	ones, _ := ipNet.Mask.Size()
+	return fmt.Sprintf("%s/%d", ip.String(), ones)
+}
+
+// DiffSuppressIp suppresses diffs between two spellings of the same IP.
+func DiffSuppressIp(k, old, new string, d *schema.ResourceData) bool {
+	return NormalizeIp(old) == NormalizeIp(new)
+}
+
+// NormalizeFqdn returns a fully qualified domain name with its trailing dot,
+// as returned by the API for reverses.
+func NormalizeFqdn(value string) string {
+	if value == "" || strings.HasSuffix(value, ".") {
+		return value
+	}
+	return value + "."
+}
+
+// DiffSuppressFqdn suppresses diffs between a domain name with or without
+// its trailing dot.
+func DiffSuppressFqdn(k, old, new string, d *schema.ResourceData) bool {
+	return NormalizeFqdn(old) == NormalizeFqdn(new)
+}
+
+// DiffSuppressIpBlock suppresses diffs between two spellings of the same IP block.
+func DiffSuppressIpBlock(k, old, new string, d *schema.ResourceData) bool {
+	return NormalizeIpBlock(old) == NormalizeIpBlock(new)
+}
+
 func ValidateStringEnum(value string, enum []string) error {
 	missing := true
 	for _, v := range enum {
@@ -180,6 +230,106 @@ func ValidateDedicatedCephACLFamily(value string) error {
 	})
 }
 
+func ValidateIntEnum(value int, enum []int) error {
+	missing := true
+	for _, v := range enum {
+		if value == v {
+			missing = false
+		}
+	}
+	if missing {
+		return fmt.Errorf("Value %d is not among valid values (%v)", value, enum)
+	}
+	return nil
+}
+
+func ValidateDnssecAlgorithm(value int) error {
+	// accepted DNSKEY algorithms for DS records
+	return ValidateIntEnum(value, []int{5, 7, 8, 10, 13, 14, 15, 16})
+}
+
+func ValidateDnssecFlags(value int) error {
+	// accepted DNSKEY flags for DS records: ZSK or KSK
+	return ValidateIntEnum(value, []int{256, 257})
+}
+
+func ValidateIpType(value string) error {
+	return ValidateStringEnum(value, []string{
+		"cloud",
+		"dedicated",
+		"failover",
+		"hosted_ssl",
+		"housing",
+		"loadBalancing",
+		"mail",
+		"overthebox",
+		"pcc",
+		"pci",
+		"private",
+		"vpn",
+		"vps",
+		"vrack",
+		"xdsl",
+	})
+}
+
+func ValidateIpFirewallRuleAction(value string) error {
+	return ValidateStringEnum(value, []string{"deny", "permit"})
+}
+
+func ValidateIpFirewallRuleProtocol(value string) error {
+	return ValidateStringEnum(value, []string{"ah", "esp", "gre", "icmp", "ipv4", "tcp", "udp"})
+}
+
+func ValidateIpFirewallRuleTcpOption(value string) error {
+	return ValidateStringEnum(value, []string{"established", "syn"})
+}
+
+func ValidateIpMitigationAutoTimeOut(value int) error {
+	// accepted auto mitigation timeouts, in minutes
+	return ValidateIntEnum(value, []int{0, 15, 1560, 3120})
+}
+
+func ValidateIpMitigationStatsScale(value string) error {
+	return ValidateStringEnum(value, []string{"10s", "1m", "5m"})
+}
+
+func ValidateDedicatedServerVirtualMacType(value string) error {
+	return ValidateStringEnum(value, []string{"ovh", "vmware"})
+}
+
+func ValidateIpUnblockType(value string) error {
+	return ValidateStringEnum(value, []string{"antihack", "spam"})
+}
+
+func ValidateIpLoadbalancingSslType(value string) error {
+	return ValidateStringEnum(value, []string{"built", "custom", "external"})
+}
+
+func ValidateIpLoadbalancingRouteRuleMatch(value string) error {
+	return ValidateStringEnum(value, []string{"contains", "endswith", "exists", "in", "internal", "is", "matches", "startswith"})
+}
+
+var ipLoadbalancingRedirectVariable = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// ValidateIpLoadbalancingRedirectTarget checks the variables used in the
+// URL template of a redirect route action.
+func ValidateIpLoadbalancingRedirectTarget(value string) error {
+	for _, m := range ipLoadbalancingRedirectVariable.FindAllStringSubmatch(value, -1) {
+		if err := ValidateStringEnum(m[1], []string{"arguments", "host", "path", "port", "protocol", "uri"}); err != nil {
+			return fmt.Errorf("Invalid variable %s in redirect target %s: %s", m[0], value, err)
+		}
+	}
+	return nil
+}
+
+func ValidateRFC3339Date(value string) error {
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		return fmt.Errorf("Value %s is not a valid RFC3339 date: %s", value, err)
+	}
+	return nil
+}
+
 func GetNilBoolPointerFromData(data interface{}, id string) *bool {
 	if resourceData, tok := data.(*schema.ResourceData); tok {
 		if val, ok := resourceData.GetOk(id); ok {
@@ -289,6 +439,22 @@ func ConditionalAttributeBool(buff *bytes.Buffer, name string, val *bool) {
 	}
 }
 
+const passwordChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// GeneratePassword returns a random alphanumeric password of the given length.
+func GeneratePassword(length int) (string, error) {
+	password := make([]byte, length)
+	max := big.NewInt(int64(len(passwordChars)))
+	for i := range password {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("Could not generate password: %s", err)
+		}
+		password[i] = passwordChars[n.Int64()]
+	}
+	return string(password), nil
+}
+
 // CheckDeleted checks the error to see if it's a 404 (Not Found) and, if so,
 // sets the resource ID to the empty string instead of throwing an error.
 func CheckDeleted(d *schema.ResourceData, err error, endpoint string) error {
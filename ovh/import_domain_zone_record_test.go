@@ -0,0 +1,68 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDomainZoneRecord_importBasic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE")
+	subdomain := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckOvhDomainZoneRecordConfig_A(zone, subdomain, "192.168.0.10", 3600),
+			},
+			{
+				ResourceName:      "ovh_domain_zone_record.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDomainZoneRecordImportId("ovh_domain_zone_record.foobar"),
+			},
+			{
+				ResourceName:      "ovh_domain_zone_record.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDomainZoneRecordNaturalKeyImportId("ovh_domain_zone_record.foobar"),
+			},
+		},
+	})
+}
+
+func testAccDomainZoneRecordImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		record, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("ovh_domain_zone_record not found: %s", resourceName)
+		}
+		return fmt.Sprintf(
+			"%s.%s",
+			record.Primary.ID,
+			record.Primary.Attributes["zone"],
+		), nil
+	}
+}
+
+func testAccDomainZoneRecordNaturalKeyImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		record, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("ovh_domain_zone_record not found: %s", resourceName)
+		}
+		return fmt.Sprintf(
+			"%s/%s/%s/%s",
+			record.Primary.Attributes["zone"],
+			record.Primary.Attributes["subdomain"],
+			record.Primary.Attributes["fieldtype"],
+			record.Primary.Attributes["target"],
+		), nil
+	}
+}
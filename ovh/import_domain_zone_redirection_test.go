@@ -0,0 +1,68 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDomainZoneRedirection_importBasic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE")
+	subdomain := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckOvhDomainZoneRedirectionConfig_basic, zone, subdomain),
+			},
+			{
+				ResourceName:      "ovh_domain_zone_redirection.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDomainZoneRedirectionImportId("ovh_domain_zone_redirection.foobar"),
+			},
+			{
+				ResourceName:      "ovh_domain_zone_redirection.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDomainZoneRedirectionNaturalKeyImportId("ovh_domain_zone_redirection.foobar"),
+			},
+		},
+	})
+}
+
+func testAccDomainZoneRedirectionImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		redirection, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("ovh_domain_zone_redirection not found: %s", resourceName)
+		}
+		return fmt.Sprintf(
+			"%s.%s",
+			redirection.Primary.ID,
+			redirection.Primary.Attributes["zone"],
+		), nil
+	}
+}
+
+func testAccDomainZoneRedirectionNaturalKeyImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		redirection, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("ovh_domain_zone_redirection not found: %s", resourceName)
+		}
+		return fmt.Sprintf(
+			"%s/%s/%s/%s",
+			redirection.Primary.Attributes["zone"],
+			redirection.Primary.Attributes["subdomain"],
+			redirection.Primary.Attributes["type"],
+			redirection.Primary.Attributes["target"],
+		), nil
+	}
+}
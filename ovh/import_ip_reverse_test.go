@@ -0,0 +1,41 @@
+package ovh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIpReverse_importBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpReverseConfig,
+			},
+			{
+				ResourceName:      "ovh_ip_reverse.reverse",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIpReverseImportId("ovh_ip_reverse.reverse"),
+			},
+		},
+	})
+}
+
+func testAccIpReverseImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		reverse, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("ovh_ip_reverse not found: %s", resourceName)
+		}
+		return fmt.Sprintf(
+			"%s|%s",
+			reverse.Primary.Attributes["ip"],
+			reverse.Primary.Attributes["ipreverse"],
+		), nil
+	}
+}
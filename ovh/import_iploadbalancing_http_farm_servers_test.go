@@ -0,0 +1,49 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIpLoadbalancingHttpFarmServers_importBasic(t *testing.T) {
+	prefix := fmt.Sprintf(
+		testAccIpLoadbalancingHttpFarmServersConfig_templ,
+		os.Getenv("OVH_IPLB_SERVICE"),
+		acctest.RandomWithPrefix(test_prefix),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpLoadbalancing(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingHttpFarmServersConfig_step0, prefix),
+			},
+			{
+				ResourceName:      "ovh_iploadbalancing_http_farm_servers.testacc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIpLoadbalancingFarmServersImportId("ovh_iploadbalancing_http_farm_servers.testacc"),
+			},
+		},
+	})
+}
+
+func testAccIpLoadbalancingFarmServersImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		servers, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("farm servers not found: %s", resourceName)
+		}
+		return fmt.Sprintf(
+			"%s/%s",
+			servers.Primary.Attributes["service_name"],
+			servers.Primary.Attributes["farm_id"],
+		), nil
+	}
+}
@@ -0,0 +1,30 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpLoadbalancingSslFree_importBasic(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+	fqdn := os.Getenv("OVH_IPLB_SSL_FREE_FQDN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpLoadbalancingSslFree(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingSslFreeConfig, iplb, fqdn, test_prefix),
+			},
+			{
+				ResourceName:      "ovh_iploadbalancing_ssl_free.cert",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIpLoadbalancingSslImportId("ovh_iploadbalancing_ssl_free.cert"),
+			},
+		},
+	})
+}
@@ -0,0 +1,48 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIpLoadbalancingSsl_importBasic(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+	certificate, key := testAccIpLoadbalancingSslSelfSigned(t, fmt.Sprintf("%s.example.com", name))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckIpLoadbalancingSslPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingSslConfig, iplb, certificate, key, name),
+			},
+			{
+				ResourceName:            "ovh_iploadbalancing_ssl.cert",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate", "key", "chain"},
+				ImportStateIdFunc:       testAccIpLoadbalancingSslImportId("ovh_iploadbalancing_ssl.cert"),
+			},
+		},
+	})
+}
+
+func testAccIpLoadbalancingSslImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		ssl, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("ovh_iploadbalancing_ssl not found: %s", resourceName)
+		}
+		return fmt.Sprintf(
+			"%s/%s",
+			ssl.Primary.Attributes["service_name"],
+			ssl.Primary.ID,
+		), nil
+	}
+}
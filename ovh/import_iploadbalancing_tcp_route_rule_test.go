@@ -0,0 +1,44 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIpLoadbalancingTcpRouteRule_importBasic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpLoadbalancing(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingTcpRouteRuleConfig_basic, os.Getenv("OVH_IPLB_SERVICE"), "Test rule", "sni", "is", "false", "example.com"),
+			},
+			{
+				ResourceName:      "ovh_iploadbalancing_tcp_route_rule.testrule",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIpLoadbalancingTcpRouteRuleImportId("ovh_iploadbalancing_tcp_route_rule.testrule"),
+			},
+		},
+	})
+}
+
+func testAccIpLoadbalancingTcpRouteRuleImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		testRouteRule, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("ovh_ip_loadbalancing_route_rule not found: %s", resourceName)
+		}
+		return fmt.Sprintf(
+			"%s/%s/%s",
+			testRouteRule.Primary.Attributes["service_name"],
+			testRouteRule.Primary.Attributes["route_id"],
+			testRouteRule.Primary.Attributes["id"],
+		), nil
+	}
+}
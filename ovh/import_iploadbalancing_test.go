@@ -0,0 +1,30 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpLoadbalancing_importBasic(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckIpLoadbalancingPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingConfig, iplb, name, "intermediate"),
+			},
+			{
+				ResourceName:      "ovh_iploadbalancing.iplb",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
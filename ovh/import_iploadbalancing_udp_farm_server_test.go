@@ -0,0 +1,63 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIpLoadbalancingUdpFarmServer_importBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpLoadbalancing(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingUdpFarmServerConfig, os.Getenv("OVH_IPLB_SERVICE"), test_prefix, "testserver", 53, "active"),
+			},
+			{
+				ResourceName:      "ovh_iploadbalancing_udp_farm_server.testfarmserver",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIpLoadbalancingUdpFarmServerImportId("ovh_iploadbalancing_udp_farm_server.testfarmserver"),
+			},
+			{
+				ResourceName:      "ovh_iploadbalancing_udp_farm.testfarm",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIpLoadbalancingUdpFarmImportId("ovh_iploadbalancing_udp_farm.testfarm"),
+			},
+		},
+	})
+}
+
+func testAccIpLoadbalancingUdpFarmServerImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		testFarmServer, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("ovh_iploadbalancing_udp_farm_server not found: %s", resourceName)
+		}
+		return fmt.Sprintf(
+			"%s/%s/%s",
+			testFarmServer.Primary.Attributes["service_name"],
+			testFarmServer.Primary.Attributes["farm_id"],
+			testFarmServer.Primary.Attributes["id"],
+		), nil
+	}
+}
+
+func testAccIpLoadbalancingUdpFarmImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		testFarm, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("ovh_iploadbalancing_udp_farm not found: %s", resourceName)
+		}
+		return fmt.Sprintf(
+			"%s/%s",
+			testFarm.Primary.Attributes["service_name"],
+			testFarm.Primary.Attributes["id"],
+		), nil
+	}
+}
@@ -0,0 +1,42 @@
+package ovh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVrackIp_importBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckVrackIpPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVrackIpConfig,
+			},
+			{
+				ResourceName:      "ovh_vrack_ip.vip",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVrackIpImportId("ovh_vrack_ip.vip"),
+			},
+		},
+	})
+}
+
+func testAccVrackIpImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		vip, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("vrack ip not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf(
+			"%s|%s",
+			vip.Primary.Attributes["service_name"],
+			vip.Primary.Attributes["block"],
+		), nil
+	}
+}
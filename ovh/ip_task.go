@@ -0,0 +1,58 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func waitForIpTask(ip string, task *IpTask, timeout time.Duration, c *ovh.Client) error {
+	taskId := task.Id
+
+	refreshFunc := func() (interface{}, string, error) {
+		task, err := getIpTask(ip, taskId, c)
+		if err != nil {
+			return taskId, "", err
+		}
+
+		log.Printf("[INFO] Pending Task id %d on IP %s status: %s", taskId, ip, task.Status)
+		return taskId, task.Status, nil
+	}
+
+	log.Printf("[INFO] Waiting for IP Task id %s/%d", ip, taskId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"init", "todo", "doing"},
+		Target:     []string{"done"},
+		Refresh:    refreshFunc,
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for IP task %s/%d to complete: %s", ip, taskId, err)
+	}
+
+	return nil
+}
+
+func getIpTask(ip string, taskId int64, c *ovh.Client) (*IpTask, error) {
+	task := &IpTask{}
+	endpoint := fmt.Sprintf(
+		"/ip/%s/task/%d",
+		url.PathEscape(ip),
+		taskId,
+	)
+
+	if err := c.Get(endpoint, task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
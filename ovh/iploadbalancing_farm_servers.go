@@ -0,0 +1,449 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// resourceIpLoadbalancingFarmServers returns a resource managing all the
+// servers of a farm of the given protocol: servers of the farm which are
+// not declared in the resource are deleted.
+func resourceIpLoadbalancingFarmServers(protocol string) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ipLoadbalancingAutoRefreshed(func(d *schema.ResourceData, meta interface{}) error {
+			return resourceIpLoadbalancingFarmServersCreate(protocol, d, meta)
+		}),
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceIpLoadbalancingFarmServersRead(protocol, d, meta)
+		},
+		UpdateContext: ipLoadbalancingAutoRefreshed(func(d *schema.ResourceData, meta interface{}) error {
+			return resourceIpLoadbalancingFarmServersUpdate(protocol, d, meta)
+		}),
+		DeleteContext: ipLoadbalancingAutoRefreshed(func(d *schema.ResourceData, meta interface{}) error {
+			return resourceIpLoadbalancingFarmServersDelete(protocol, d, meta)
+		}),
+		Importer: &schema.ResourceImporter{
+			State: resourceIpLoadbalancingFarmServersImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"farm_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"server": {
+				Type:        schema.TypeSet,
+				Description: "All the servers of the farm, identified by their address and port",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								ip := v.(string)
+								if net.ParseIP(ip).To4() == nil {
+									errors = append(errors, fmt.Errorf("Address %s is not an IPv4", ip))
+								}
+								return
+							},
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Description: "Port of the server, defaults to the port of the farm",
+							Optional:    true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "active",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := helpers.ValidateStringEnum(v.(string), []string{"active", "inactive"})
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+						"backup": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"probe": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"ssl": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"proxy_protocol_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := helpers.ValidateStringEnum(v.(string), []string{"v1", "v2", "v2-ssl", "v2-ssl-cn"})
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"chain": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIpLoadbalancingFarmServersImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not service_name/farm_id formatted")
+	}
+	farmId, err := strconv.Atoi(splitId[1])
+	if err != nil {
+		return nil, fmt.Errorf("Couldn't cast farmId %s to int: %s", splitId[1], err.Error())
+	}
+
+	d.SetId(splitId[1])
+	d.Set("farm_id", farmId)
+	d.Set("service_name", splitId[0])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpLoadbalancingFarmServersCreate(protocol string, d *schema.ResourceData, meta interface{}) error {
+	farmId := d.Get("farm_id").(int)
+
+	if err := updateIpLoadbalancingFarmServers(protocol, d, meta); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", farmId))
+
+	return resourceIpLoadbalancingFarmServersRead(protocol, d, meta)
+}
+
+func resourceIpLoadbalancingFarmServersRead(protocol string, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	farmId := d.Get("farm_id").(int)
+
+	servers, err := getIpLoadbalancingFarmServers(protocol, service, farmId, config.OVHClient)
+	if err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			log.Printf("[WARN] %s farm %d of IPLB %s not found, removing its servers from state", protocol, farmId, service)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	mapped := make([]interface{}, len(servers))
+	for i, server := range servers {
+		mapped[i] = ipLoadbalancingFarmServerToSetItem(server)
+	}
+	d.Set("server", mapped)
+
+	return nil
+}
+
+func resourceIpLoadbalancingFarmServersUpdate(protocol string, d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("server") {
+		if err := updateIpLoadbalancingFarmServers(protocol, d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceIpLoadbalancingFarmServersRead(protocol, d, meta)
+}
+
+func resourceIpLoadbalancingFarmServersDelete(protocol string, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	farmId := d.Get("farm_id").(int)
+
+	servers, err := getIpLoadbalancingFarmServers(protocol, service, farmId, config.OVHClient)
+	if err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	for _, server := range servers {
+		if err := deleteIpLoadbalancingFarmServer(protocol, service, farmId, server.ServerId, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// updateIpLoadbalancingFarmServers reconciles the servers of the farm with
+// the ones of the resource, matching them on their address and port:
+// matching servers are updated when they differ, missing ones are created
+// and the other servers of the farm are deleted.
+func updateIpLoadbalancingFarmServers(protocol string, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	farmId := d.Get("farm_id").(int)
+
+	wanted := map[string]map[string]interface{}{}
+	for _, v := range d.Get("server").(*schema.Set).List() {
+		item := v.(map[string]interface{})
+		key := ipLoadbalancingFarmServerKey(item["address"].(string), item["port"].(int))
+		if _, ok := wanted[key]; ok {
+			return fmt.Errorf("Server %s is declared more than once", key)
+		}
+		wanted[key] = item
+	}
+
+	servers, err := getIpLoadbalancingFarmServers(protocol, service, farmId, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]*IpLoadbalancingFarmServer{}
+	for _, server := range servers {
+		port := 0
+		if server.Port != nil {
+			port = *server.Port
+		}
+		key := ipLoadbalancingFarmServerKey(server.Address, port)
+
+		// servers of the farm which are not declared, or declared
+		// more than once, are deleted
+		if _, ok := wanted[key]; !ok || existing[key] != nil {
+			if err := deleteIpLoadbalancingFarmServer(protocol, service, farmId, server.ServerId, config.OVHClient); err != nil {
+				return err
+			}
+			continue
+		}
+		existing[key] = server
+	}
+
+	keys := make([]string, 0, len(wanted))
+	for key := range wanted {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		item := wanted[key]
+		server, ok := existing[key]
+
+		if !ok {
+			endpoint := fmt.Sprintf(
+				"/ipLoadbalancing/%s/%s/farm/%d/server",
+				url.PathEscape(service),
+				protocol,
+				farmId,
+			)
+
+			opts := &IpLoadbalancingFarmServerCreateOpts{
+				Address:              item["address"].(string),
+				Backup:               helpers.GetNilBoolPointer(item["backup"]),
+				Chain:                helpers.GetNilStringPointer(item["chain"]),
+				DisplayName:          helpers.GetNilStringPointer(item["display_name"]),
+				Port:                 ipLoadbalancingFarmServerPort(item),
+				Probe:                helpers.GetNilBoolPointer(item["probe"]),
+				ProxyProtocolVersion: helpers.GetNilStringPointer(item["proxy_protocol_version"]),
+				Ssl:                  helpers.GetNilBoolPointer(item["ssl"]),
+				Status:               item["status"].(string),
+				Weight:               helpers.GetNilIntPointer(item["weight"]),
+			}
+
+			if err := config.OVHClient.Post(endpoint, opts, nil); err != nil {
+				return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+			}
+			log.Printf("[DEBUG] Created server %s on %s farm %d of IPLB %s", key, protocol, farmId, service)
+			continue
+		}
+
+		if reflect.DeepEqual(ipLoadbalancingFarmServerToSetItem(server), item) {
+			continue
+		}
+
+		endpoint := fmt.Sprintf(
+			"/ipLoadbalancing/%s/%s/farm/%d/server/%d",
+			url.PathEscape(service),
+			protocol,
+			farmId,
+			server.ServerId,
+		)
+
+		opts := &IpLoadbalancingFarmServerUpdateOpts{
+			Address:              helpers.GetNilStringPointer(item["address"]),
+			Backup:               helpers.GetNilBoolPointer(item["backup"]),
+			Chain:                helpers.GetNilStringPointer(item["chain"]),
+			DisplayName:          helpers.GetNilStringPointer(item["display_name"]),
+			Port:                 ipLoadbalancingFarmServerPort(item),
+			Probe:                helpers.GetNilBoolPointer(item["probe"]),
+			ProxyProtocolVersion: helpers.GetNilStringPointer(item["proxy_protocol_version"]),
+			Ssl:                  helpers.GetNilBoolPointer(item["ssl"]),
+			Status:               helpers.GetNilStringPointer(item["status"]),
+			Weight:               helpers.GetNilIntPointer(item["weight"]),
+		}
+
+		if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+			return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+		log.Printf("[DEBUG] Updated server %s on %s farm %d of IPLB %s", key, protocol, farmId, service)
+	}
+
+	return nil
+}
+
+func ipLoadbalancingFarmServerKey(address string, port int) string {
+	return fmt.Sprintf("%s:%d", address, port)
+}
+
+// ipLoadbalancingFarmServerPort returns the port of a server of the set,
+// nil when the server uses the port of the farm.
+func ipLoadbalancingFarmServerPort(item map[string]interface{}) *int {
+	if port := item["port"].(int); port != 0 {
+		return &port
+	}
+	return nil
+}
+
+// ipLoadbalancingFarmServerToSetItem maps a server of a farm to an item
+// of the server set, with the zero values of the schema for unset fields.
+func ipLoadbalancingFarmServerToSetItem(server *IpLoadbalancingFarmServer) map[string]interface{} {
+	obj := map[string]interface{}{
+		"address":                server.Address,
+		"port":                   0,
+		"display_name":           "",
+		"status":                 server.Status,
+		"weight":                 0,
+		"backup":                 false,
+		"probe":                  false,
+		"ssl":                    false,
+		"proxy_protocol_version": "",
+		"chain":                  "",
+	}
+
+	if server.Port != nil {
+		obj["port"] = *server.Port
+	}
+	if server.DisplayName != nil {
+		obj["display_name"] = *server.DisplayName
+	}
+	if server.Weight != nil {
+		obj["weight"] = *server.Weight
+	}
+	if server.Backup != nil {
+		obj["backup"] = *server.Backup
+	}
+	if server.Probe != nil {
+		obj["probe"] = *server.Probe
+	}
+	if server.Ssl != nil {
+		obj["ssl"] = *server.Ssl
+	}
+	if server.ProxyProtocolVersion != nil {
+		obj["proxy_protocol_version"] = *server.ProxyProtocolVersion
+	}
+	if server.Chain != nil {
+		obj["chain"] = *server.Chain
+	}
+
+	return obj
+}
+
+// getIpLoadbalancingFarmServers returns all the servers of a farm, sorted by id.
+// Errors listing the servers are returned as is, so that callers can check
+// whether the farm exists.
+func getIpLoadbalancingFarmServers(protocol, service string, farmId int, c *ovh.Client) ([]*IpLoadbalancingFarmServer, error) {
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/%s/farm/%d/server",
+		url.PathEscape(service),
+		protocol,
+		farmId,
+	)
+
+	ids := []int{}
+	if err := c.Get(endpoint, &ids); err != nil {
+		return nil, err
+	}
+
+	sort.Ints(ids)
+
+	servers := make([]*IpLoadbalancingFarmServer, len(ids))
+	for i, id := range ids {
+		endpoint := fmt.Sprintf(
+			"/ipLoadbalancing/%s/%s/farm/%d/server/%d",
+			url.PathEscape(service),
+			protocol,
+			farmId,
+			id,
+		)
+
+		servers[i] = &IpLoadbalancingFarmServer{}
+		if err := c.Get(endpoint, servers[i]); err != nil {
+			return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+	}
+
+	return servers, nil
+}
+
+func deleteIpLoadbalancingFarmServer(protocol, service string, farmId, serverId int, c *ovh.Client) error {
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/%s/farm/%d/server/%d",
+		url.PathEscape(service),
+		protocol,
+		farmId,
+		serverId,
+	)
+
+	if err := c.Delete(endpoint, nil); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			return nil
+		}
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Deleted server %d of %s farm %d of IPLB %s", serverId, protocol, farmId, service)
+	return nil
+}
@@ -0,0 +1,231 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// ipLoadbalancingAutoRefreshSettleTime is how long the automatic refresh of a
+// service waits without any other change of the service before starting, so
+// that resources changed concurrently share a single refresh.
+const ipLoadbalancingAutoRefreshSettleTime = 5 * time.Second
+
+// ipLoadbalancingRefreshes serializes the automatic refreshes of the IP
+// load balancing services, so that the changes made by concurrent resources
+// on a service are applied by as few refreshes as possible.
+type ipLoadbalancingRefreshes struct {
+	mu       sync.Mutex
+	services map[string]*ipLoadbalancingServiceRefresh
+}
+
+type ipLoadbalancingServiceRefresh struct {
+	mu sync.Mutex
+	// start of the last refresh of the service, every change made
+	// before that date has been applied by the refresh
+	lastStart time.Time
+
+	changeMu   sync.Mutex
+	lastChange time.Time
+}
+
+func newIpLoadbalancingRefreshes() *ipLoadbalancingRefreshes {
+	return &ipLoadbalancingRefreshes{
+		services: map[string]*ipLoadbalancingServiceRefresh{},
+	}
+}
+
+func (r *ipLoadbalancingRefreshes) service(serviceName string) *ipLoadbalancingServiceRefresh {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.services[serviceName]
+	if !ok {
+		s = &ipLoadbalancingServiceRefresh{}
+		r.services[serviceName] = s
+	}
+	return s
+}
+
+// changed records a change of the service and returns its date.
+func (s *ipLoadbalancingServiceRefresh) changed() time.Time {
+	s.changeMu.Lock()
+	defer s.changeMu.Unlock()
+
+	s.lastChange = time.Now()
+	return s.lastChange
+}
+
+// settle waits until the service has not changed for the settle time.
+func (s *ipLoadbalancingServiceRefresh) settle() {
+	for {
+		s.changeMu.Lock()
+		wait := time.Until(s.lastChange.Add(ipLoadbalancingAutoRefreshSettleTime))
+		s.changeMu.Unlock()
+
+		if wait <= 0 {
+			return
+		}
+		time.Sleep(wait)
+	}
+}
+
+// ipLoadbalancingAutoRefreshed wraps the create, update or delete function of
+// an IP load balancing resource to refresh its service once the function
+// succeeded, see ipLoadbalancingAutoRefresh.
+func ipLoadbalancingAutoRefreshed(f func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		service := d.Get("service_name").(string)
+
+		if err := f(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return ipLoadbalancingAutoRefresh(service, meta)
+	}
+}
+
+// ipLoadbalancingAutoRefresh applies the pending changes of the service once
+// a resource has changed its configuration, when enabled on the provider.
+// The refresh starts once the service has settled, changes made by other
+// resources in the meantime are applied by the same refresh. Resources
+// depending on each other are still changed, and refreshed, one after the
+// other.
+//
+// The configuration change itself succeeded, a refresh failure must not taint
+// the resource: it is returned as a warning, and the changes are left pending
+// until the next refresh.
+func ipLoadbalancingAutoRefresh(serviceName string, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	if !config.IpLoadbalancingAutoRefresh {
+		return nil
+	}
+
+	s := config.ipLoadbalancingRefreshes.service(serviceName)
+	changedAt := s.changed()
+	s.settle()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lastStart.After(changedAt) {
+		log.Printf("[DEBUG] Changes on IPLB %s already applied by a refresh", serviceName)
+		return nil
+	}
+
+	s.lastStart = time.Now()
+	if err := refreshIpLoadbalancing(serviceName, config.OVHClient); err != nil {
+		log.Printf("[WARN] Automatic refresh of IPLB %s failed, its changes are left pending: %s", serviceName, err)
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Automatic refresh of IP load balancing %s failed", serviceName),
+				Detail: fmt.Sprintf(
+					"The change was applied to the configuration of the service but is not live yet, "+
+						"it is left pending until the next refresh (see the ovh_iploadbalancing_refresh resource): %s",
+					err,
+				),
+			},
+		}
+	}
+
+	return nil
+}
+
+// refreshIpLoadbalancing waits for the running refreshes of the service,
+// then refreshes each zone with pending changes and waits for the tasks.
+func refreshIpLoadbalancing(serviceName string, c *ovh.Client) error {
+	// verify if there are no active tasks for the loadbalancer
+	// at the moment and wait till finished if there are any
+
+	stateConf := &resource.StateChangeConf{
+		Target: []string{"empty"},
+		Refresh: func() (interface{}, string, error) {
+			for _, state := range []string{"todo", "doing"} {
+				taskResp := &[]int{}
+				endpoint := fmt.Sprintf(
+					"/ipLoadbalancing/%s/task?action=refreshIplb&status=%s",
+					url.PathEscape(serviceName),
+					state,
+				)
+				err := c.Get(endpoint, taskResp)
+				if err != nil {
+					return taskResp, "error", fmt.Errorf("calling GET %s :\n\t %s", endpoint, err.Error())
+				}
+				if len(*taskResp) > 0 {
+					return taskResp, "exists", nil
+				}
+			}
+			return serviceName, "empty", nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for IPLoadbalancer tasks to finish: %s", err)
+	}
+
+	// verify if there are any outstanding changes to refresh
+
+	pendings, err := getIpLoadbalancingPendingChanges(serviceName, c)
+	if err != nil {
+		return err
+	}
+
+	// proceed with refresh
+
+	taskIds := []int{}
+	for _, pending := range pendings {
+		if pending.Number == 0 {
+			continue
+		}
+
+		resp := &IPLoadbalancingRefreshTask{}
+		endpoint := fmt.Sprintf(
+			"/ipLoadbalancing/%s/refresh",
+			url.PathEscape(serviceName),
+		)
+		opts := &IpLoadbalancingRefreshOpts{Zone: pending.Zone}
+
+		if err := c.Post(endpoint, opts, resp); err != nil {
+			return fmt.Errorf("calling POST %s with opts %v:\n\t %s", endpoint, opts, err.Error())
+		}
+
+		log.Printf("[DEBUG] Refreshing zone %s of IPLB %s with task %d", pending.Zone, serviceName, resp.ID)
+		taskIds = append(taskIds, resp.ID)
+	}
+
+	for _, taskId := range taskIds {
+		if err := waitForIpLoadbalancingTask(serviceName, taskId, c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getIpLoadbalancingPendingChanges(serviceName string, c *ovh.Client) (IPLoadbalancingRefreshPendings, error) {
+	pendings := IPLoadbalancingRefreshPendings{}
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/pendingChanges",
+		url.PathEscape(serviceName),
+	)
+
+	if err := c.Get(endpoint, &pendings); err != nil {
+		return nil, fmt.Errorf("calling GET %s :\n\t %s", endpoint, err.Error())
+	}
+
+	return pendings, nil
+}
@@ -0,0 +1,220 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// ipLoadbalancingAvailableRoutes caches the route actions and rules
+// available on the IP load balancing services, fetched once per service.
+type ipLoadbalancingAvailableRoutes struct {
+	mu       sync.Mutex
+	services map[string]*ipLoadbalancingServiceAvailableRoutes
+}
+
+type ipLoadbalancingServiceAvailableRoutes struct {
+	Actions []*IpLoadbalancingRouteAvailableAction
+	Rules   []*IpLoadbalancingRouteAvailableRule
+}
+
+func newIpLoadbalancingAvailableRoutes() *ipLoadbalancingAvailableRoutes {
+	return &ipLoadbalancingAvailableRoutes{
+		services: map[string]*ipLoadbalancingServiceAvailableRoutes{},
+	}
+}
+
+func (r *ipLoadbalancingAvailableRoutes) service(serviceName string, c *ovh.Client) (*ipLoadbalancingServiceAvailableRoutes, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if s, ok := r.services[serviceName]; ok {
+		return s, nil
+	}
+
+	s := &ipLoadbalancingServiceAvailableRoutes{}
+
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/availableRouteActions",
+		url.PathEscape(serviceName),
+	)
+	if err := c.Get(endpoint, &s.Actions); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	endpoint = fmt.Sprintf(
+		"/ipLoadbalancing/%s/availableRouteRules",
+		url.PathEscape(serviceName),
+	)
+	if err := c.Get(endpoint, &s.Rules); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	r.services[serviceName] = s
+	return s, nil
+}
+
+// getIpLoadbalancingAvailableRoutes returns the route actions and rules
+// available on the service of the diff, nil when they can't be known yet.
+func getIpLoadbalancingAvailableRoutes(d *schema.ResourceDiff, meta interface{}) (*ipLoadbalancingServiceAvailableRoutes, error) {
+	config, ok := meta.(*Config)
+	if !ok || config.OVHClient == nil || config.ipLoadbalancingAvailableRoutes == nil {
+		return nil, nil
+	}
+
+	if !d.NewValueKnown("service_name") {
+		return nil, nil
+	}
+
+	return config.ipLoadbalancingAvailableRoutes.service(d.Get("service_name").(string), config.OVHClient)
+}
+
+// ipLoadbalancingRouteCustomizeDiff validates the action of a route of the
+// given protocol, and its inline rules if any, against the actions and rules
+// available on the service.
+func ipLoadbalancingRouteCustomizeDiff(protocol string, withStatus, withRules bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		available, err := getIpLoadbalancingAvailableRoutes(d, meta)
+		if err != nil || available == nil {
+			return err
+		}
+
+		if err := validateIpLoadbalancingRouteAction(protocol, withStatus, "action.0.", d, available); err != nil {
+			return err
+		}
+
+		if !withRules || !d.NewValueKnown("rules") {
+			return nil
+		}
+
+		for i := range d.Get("rules").([]interface{}) {
+			if err := validateIpLoadbalancingRouteRule(protocol, fmt.Sprintf("rules.%d.", i), d, available); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// ipLoadbalancingRouteRuleCustomizeDiff validates a rule of a route of the
+// given protocol against the rules available on the service.
+func ipLoadbalancingRouteRuleCustomizeDiff(protocol string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		available, err := getIpLoadbalancingAvailableRoutes(d, meta)
+		if err != nil || available == nil {
+			return err
+		}
+
+		return validateIpLoadbalancingRouteRule(protocol, "", d, available)
+	}
+}
+
+func validateIpLoadbalancingRouteAction(protocol string, withStatus bool, prefix string, d *schema.ResourceDiff, available *ipLoadbalancingServiceAvailableRoutes) error {
+	if !d.NewValueKnown(prefix + "type") {
+		return nil
+	}
+	name := d.Get(prefix + "type").(string)
+
+	var action *IpLoadbalancingRouteAvailableAction
+	names := []string{}
+	for _, a := range available.Actions {
+		if a.Type != protocol {
+			continue
+		}
+		names = append(names, a.Name)
+		if a.Name == name {
+			action = a
+		}
+	}
+
+	if action == nil {
+		return fmt.Errorf("%stype: %s route action %s is not among available actions (%s)", prefix, protocol, name, names)
+	}
+
+	if withStatus && d.NewValueKnown(prefix+"status") {
+		if status := d.Get(prefix + "status").(int); status != 0 {
+			if len(action.Status) == 0 {
+				return fmt.Errorf("%sstatus: %s route action %s doesn't take a status", prefix, protocol, name)
+			}
+			if err := helpers.ValidateIntEnum(status, action.Status); err != nil {
+				return fmt.Errorf("%sstatus: %s", prefix, err)
+			}
+		}
+	}
+
+	if !d.NewValueKnown(prefix + "target") {
+		return nil
+	}
+	target := d.Get(prefix + "target").(string)
+
+	if action.Destination != nil && target == "" {
+		return fmt.Errorf("%starget: %s route action %s requires a %s target", prefix, protocol, name, *action.Destination)
+	}
+
+	if name == "redirect" {
+		if err := helpers.ValidateIpLoadbalancingRedirectTarget(target); err != nil {
+			return fmt.Errorf("%starget: %s", prefix, err)
+		}
+	}
+
+	return nil
+}
+
+func validateIpLoadbalancingRouteRule(protocol string, prefix string, d *schema.ResourceDiff, available *ipLoadbalancingServiceAvailableRoutes) error {
+	if !d.NewValueKnown(prefix + "field") {
+		return nil
+	}
+	field := d.Get(prefix + "field").(string)
+
+	var rule *IpLoadbalancingRouteAvailableRule
+	names := []string{}
+	for _, r := range available.Rules {
+		if r.Type != protocol {
+			continue
+		}
+		names = append(names, r.Name)
+		if r.Name == field {
+			rule = r
+		}
+	}
+
+	if rule == nil {
+		return fmt.Errorf("%sfield: %s route rule field %s is not among available fields (%s)", prefix, protocol, field, names)
+	}
+
+	if d.NewValueKnown(prefix+"match") && len(rule.Matches) > 0 {
+		if err := helpers.ValidateStringEnum(d.Get(prefix+"match").(string), rule.Matches); err != nil {
+			return fmt.Errorf("%smatch: matching operator of field %s: %s", prefix, field, err)
+		}
+	}
+
+	if d.NewValueKnown(prefix + "sub_field") {
+		subField := d.Get(prefix + "sub_field").(string)
+		if rule.HasSubField && subField == "" {
+			return fmt.Errorf("%ssub_field: field %s requires a sub field", prefix, field)
+		}
+		if !rule.HasSubField && subField != "" {
+			return fmt.Errorf("%ssub_field: field %s doesn't take a sub field", prefix, field)
+		}
+	}
+
+	// fields with a fixed set of values, like protocol, can only be
+	// compared to one of them
+	if len(rule.Values) > 0 && d.NewValueKnown(prefix+"match") && d.NewValueKnown(prefix+"pattern") {
+		pattern := d.Get(prefix + "pattern").(string)
+		if d.Get(prefix+"match").(string) == "is" && pattern != "" {
+			if err := helpers.ValidateStringEnum(pattern, rule.Values); err != nil {
+				return fmt.Errorf("%spattern: value of field %s: %s", prefix, field, err)
+			}
+		}
+	}
+
+	return nil
+}
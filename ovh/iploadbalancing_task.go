@@ -0,0 +1,47 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func waitForIpLoadbalancingTask(serviceName string, taskId int, c *ovh.Client) error {
+	refreshFunc := func() (interface{}, string, error) {
+		task := &IPLoadbalancingRefreshTask{}
+		endpoint := fmt.Sprintf(
+			"/ipLoadbalancing/%s/task/%d",
+			url.PathEscape(serviceName),
+			taskId,
+		)
+
+		if err := c.Get(endpoint, task); err != nil {
+			return taskId, "", err
+		}
+
+		log.Printf("[DEBUG] Pending Task id %d on IPLB %s status: %s", taskId, serviceName, task.Status)
+		return taskId, task.Status, nil
+	}
+
+	log.Printf("[DEBUG] Waiting for IPLB Task id %s/%d", serviceName, taskId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"todo", "doing", "blocked"},
+		Target:     []string{"done"},
+		Refresh:    refreshFunc,
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for IPLB task %s/%d to complete: %s", serviceName, taskId, err)
+	}
+
+	return nil
+}
@@ -37,6 +37,12 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("OVH_CONSUMER_KEY", ""),
 				Description: descriptions["consumer_key"],
 			},
+			"iploadbalancing_auto_refresh": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OVH_IPLOADBALANCING_AUTO_REFRESH", false),
+				Description: descriptions["iploadbalancing_auto_refresh"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -47,10 +53,20 @@ func Provider() *schema.Provider {
 			"ovh_dedicated_server":                 dataSourceDedicatedServer(),
 			"ovh_dedicated_server_boots":           dataSourceDedicatedServerBoots(),
 			"ovh_dedicated_servers":                dataSourceDedicatedServers(),
+			"ovh_domain":                           dataSourceDomain(),
 			"ovh_domain_zone":                      dataSourceDomainZone(),
+			"ovh_domain_zone_history":              dataSourceDomainZoneHistory(),
+			"ovh_domain_zone_records":              dataSourceDomainZoneRecords(),
+			"ovh_ip":                               dataSourceIp(),
+			"ovh_ip_blocked":                       dataSourceIpBlocked(),
+			"ovh_ip_mitigation":                    dataSourceIpMitigation(),
+			"ovh_ip_reverses":                      dataSourceIpReverses(),
 			"ovh_iploadbalancing":                  dataSourceIpLoadbalancing(),
+			"ovh_iploadbalancing_pending_changes":  dataSourceIpLoadbalancingPendingChanges(),
+			"ovh_iploadbalancing_ssl":              dataSourceIpLoadbalancingSsl(),
 			"ovh_iploadbalancing_vrack_network":    dataSourceIpLoadbalancingVrackNetwork(),
 			"ovh_iploadbalancing_vrack_networks":   dataSourceIpLoadbalancingVrackNetworks(),
+			"ovh_ips":                              dataSourceIps(),
 			"ovh_me_installation_template":         dataSourceMeInstallationTemplate(),
 			"ovh_me_installation_templates":        dataSourceMeInstallationTemplates(),
 			"ovh_me_ipxe_script":                   dataSourceMeIpxeScript(),
@@ -77,18 +93,46 @@ func Provider() *schema.Provider {
 			"ovh_dedicated_server_install_task":                           resourceDedicatedServerInstallTask(),
 			"ovh_dedicated_server_reboot_task":                            resourceDedicatedServerRebootTask(),
 			"ovh_dedicated_server_update":                                 resourceDedicatedServerUpdate(),
+			"ovh_dedicated_server_virtual_mac":                            resourceDedicatedServerVirtualMac(),
+			"ovh_domain_ds_records":                                       resourceDomainDsRecords(),
+			"ovh_domain_glue_record":                                      resourceDomainGlueRecord(),
+			"ovh_domain_name_servers":                                     resourceDomainNameServers(),
+			"ovh_domain_settings":                                         resourceDomainSettings(),
+			"ovh_domain_zone_dnssec":                                      resourceDomainZoneDnssec(),
+			"ovh_domain_zone_dynhost_login":                               resourceDomainZoneDynHostLogin(),
+			"ovh_domain_zone_dynhost_record":                              resourceDomainZoneDynHostRecord(),
 			"ovh_domain_zone_record":                                      resourceOvhDomainZoneRecord(),
 			"ovh_domain_zone_redirection":                                 resourceOvhDomainZoneRedirection(),
+			"ovh_domain_zone_restore":                                     resourceDomainZoneRestore(),
+			"ovh_domain_zone_soa":                                         resourceDomainZoneSoa(),
+			"ovh_ip_firewall":                                             resourceIpFirewall(),
+			"ovh_ip_firewall_rule":                                        resourceIpFirewallRule(),
+			"ovh_ip_mitigation":                                           resourceIpMitigation(),
+			"ovh_ip_mitigation_profile":                                   resourceIpMitigationProfile(),
+			"ovh_ip_move":                                                 resourceIpMove(),
 			"ovh_ip_reverse":                                              resourceOvhIpReverse(),
+			"ovh_ip_reverses":                                             resourceIpReverses(),
+			"ovh_ip_service":                                              resourceIpService(),
+			"ovh_ip_unblock":                                              resourceIpUnblock(),
+			"ovh_iploadbalancing":                                         resourceIpLoadbalancing(),
 			"ovh_iploadbalancing_http_farm":                               resourceIpLoadbalancingHttpFarm(),
 			"ovh_iploadbalancing_http_farm_server":                        resourceIpLoadbalancingHttpFarmServer(),
+			"ovh_iploadbalancing_http_farm_servers":                       resourceIpLoadbalancingHttpFarmServers(),
 			"ovh_iploadbalancing_http_frontend":                           resourceIpLoadbalancingHttpFrontend(),
 			"ovh_iploadbalancing_http_route":                              resourceIPLoadbalancingRouteHTTP(),
 			"ovh_iploadbalancing_http_route_rule":                         resourceIPLoadbalancingRouteHTTPRule(),
 			"ovh_iploadbalancing_refresh":                                 resourceIPLoadbalancingRefresh(),
+			"ovh_iploadbalancing_ssl":                                     resourceIpLoadbalancingSsl(),
+			"ovh_iploadbalancing_ssl_free":                                resourceIpLoadbalancingSslFree(),
 			"ovh_iploadbalancing_tcp_farm":                                resourceIpLoadbalancingTcpFarm(),
 			"ovh_iploadbalancing_tcp_farm_server":                         resourceIpLoadbalancingTcpFarmServer(),
+			"ovh_iploadbalancing_tcp_farm_servers":                        resourceIpLoadbalancingTcpFarmServers(),
 			"ovh_iploadbalancing_tcp_frontend":                            resourceIpLoadbalancingTcpFrontend(),
+			"ovh_iploadbalancing_tcp_route":                               resourceIPLoadbalancingRouteTCP(),
+			"ovh_iploadbalancing_tcp_route_rule":                          resourceIPLoadbalancingRouteTCPRule(),
+			"ovh_iploadbalancing_udp_farm":                                resourceIpLoadbalancingUdpFarm(),
+			"ovh_iploadbalancing_udp_farm_server":                         resourceIpLoadbalancingUdpFarmServer(),
+			"ovh_iploadbalancing_udp_frontend":                            resourceIpLoadbalancingUdpFrontend(),
 			"ovh_iploadbalancing_vrack_network":                           resourceIPLoadbalancingVrackNetwork(),
 			"ovh_me_installation_template":                                resourceMeInstallationTemplate(),
 			"ovh_me_installation_template_partition_scheme":               resourceMeInstallationTemplatePartitionScheme(),
@@ -99,6 +143,7 @@ func Provider() *schema.Provider {
 			"ovh_vrack_cloudproject":                                      resourceVrackCloudProject(),
 			"ovh_vrack_dedicated_server":                                  resourceVrackDedicatedServer(),
 			"ovh_vrack_dedicated_server_interface":                        resourceVrackDedicatedServerInterface(),
+			"ovh_vrack_ip":                                                resourceVrackIp(),
 			"ovh_vrack_iploadbalancing":                                   resourceVrackIpLoadbalancing(),
 
 			// Legacy naming schema (publiccloud)
@@ -126,12 +171,19 @@ func init() {
 
 		"application_secret": "The OVH API Application Secret.",
 		"consumer_key":       "The OVH API Consumer key.",
+
+		"iploadbalancing_auto_refresh": "Apply the pending changes of an IP load balancing " +
+			"after each change of its farms, servers, frontends or routes.",
 	}
 }
 
 func configureProvider(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		Endpoint: d.Get("endpoint").(string),
+		Endpoint:                   d.Get("endpoint").(string),
+		IpLoadbalancingAutoRefresh: d.Get("iploadbalancing_auto_refresh").(bool),
+		ipLoadbalancingRefreshes:   newIpLoadbalancingRefreshes(),
+
+		ipLoadbalancingAvailableRoutes: newIpLoadbalancingAvailableRoutes(),
 	}
 
 	rawPath := "~/.ovh.conf"
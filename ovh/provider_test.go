@@ -78,6 +78,25 @@ func testAccPreCheckIp(t *testing.T) {
 	checkEnvOrSkip(t, "OVH_IP_REVERSE")
 }
 
+// Checks that the environment variables needed for the /ip/{ip}/move acceptance
+// tests are set. OVH_IP_MOVE_BLOCK must be a failover IP block which can be
+// moved back and forth between OVH_IP_MOVE_SERVICE_1 and OVH_IP_MOVE_SERVICE_2.
+func testAccPreCheckIpMove(t *testing.T) {
+	testAccPreCheckCredentials(t)
+	checkEnvOrSkip(t, "OVH_IP_MOVE_BLOCK")
+	checkEnvOrSkip(t, "OVH_IP_MOVE_SERVICE_1")
+	checkEnvOrSkip(t, "OVH_IP_MOVE_SERVICE_2")
+}
+
+// Checks that the environment variables needed for the unblock acceptance
+// tests are set. OVH_IP_UNBLOCK must be an IP of OVH_IP_BLOCK currently
+// blocked for the OVH_IP_UNBLOCK_TYPE reason, spam or antihack.
+func testAccPreCheckIpUnblock(t *testing.T) {
+	testAccPreCheckIp(t)
+	checkEnvOrSkip(t, "OVH_IP_UNBLOCK")
+	checkEnvOrSkip(t, "OVH_IP_UNBLOCK_TYPE")
+}
+
 // Checks that the environment variables needed for the /domain acceptance tests
 // are set.
 func testAccPreCheckDomain(t *testing.T) {
@@ -85,6 +104,13 @@ func testAccPreCheckDomain(t *testing.T) {
 	checkEnvOrSkip(t, "OVH_ZONE")
 }
 
+// Checks that the environment variables needed for the /domain/{domain}
+// acceptance tests are set. OVH_DOMAIN must be a domain registered at OVH.
+func testAccPreCheckDomainName(t *testing.T) {
+	testAccPreCheckCredentials(t)
+	checkEnvOrSkip(t, "OVH_DOMAIN")
+}
+
 // Checks that the environment variables needed for the /cloud acceptance tests
 // are set.
 func testAccPreCheckCloud(t *testing.T) {
@@ -99,6 +125,14 @@ func testAccPreCheckIpLoadbalancing(t *testing.T) {
 	checkEnvOrSkip(t, "OVH_IPLB_SERVICE")
 }
 
+// Checks that the environment variables needed for the free certificates
+// acceptance tests are set. OVH_IPLB_SSL_FREE_FQDN must be a domain name
+// pointing to OVH_IPLB_SERVICE.
+func testAccPreCheckIpLoadbalancingSslFree(t *testing.T) {
+	testAccPreCheckIpLoadbalancing(t)
+	checkEnvOrSkip(t, "OVH_IPLB_SSL_FREE_FQDN")
+}
+
 // Checks that the environment variables needed for the /vrack acceptance tests
 // are set.
 func testAccPreCheckVRack(t *testing.T) {
@@ -106,6 +140,14 @@ func testAccPreCheckVRack(t *testing.T) {
 	checkEnvOrSkip(t, "OVH_VRACK")
 }
 
+// Checks that the environment variables needed for the /vrack/{serviceName}/ip
+// acceptance tests are set. OVH_VRACK_IP_BLOCK must be an IP block of the
+// account which is not routed yet.
+func testAccPreCheckVRackIp(t *testing.T) {
+	testAccPreCheckVRack(t)
+	checkEnvOrSkip(t, "OVH_VRACK_IP_BLOCK")
+}
+
 // Checks that the environment variables needed for the /me/paymentMean acceptance tests
 // are set.
 func testAccPreCheckMePaymentMean(t *testing.T) {
@@ -118,6 +160,14 @@ func testAccPreCheckDedicatedServer(t *testing.T) {
 	checkEnvOrSkip(t, "OVH_DEDICATED_SERVER")
 }
 
+// Checks that the environment variables needed for the virtual MAC acceptance
+// tests are set. OVH_DEDICATED_SERVER_FAILOVER_IP must be a failover IP routed
+// to OVH_DEDICATED_SERVER.
+func testAccPreCheckDedicatedServerVirtualMac(t *testing.T) {
+	testAccPreCheckDedicatedServer(t)
+	checkEnvOrSkip(t, "OVH_DEDICATED_SERVER_FAILOVER_IP")
+}
+
 func testAccPreCheckVPS(t *testing.T) {
 	testAccPreCheckCredentials(t)
 	checkEnvOrSkip(t, "OVH_VPS")
@@ -0,0 +1,397 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDedicatedServerVirtualMac() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedServerVirtualMacCreate,
+		Read:   resourceDedicatedServerVirtualMacRead,
+		Update: resourceDedicatedServerVirtualMacUpdate,
+		Delete: resourceDedicatedServerVirtualMacDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDedicatedServerVirtualMacImportState,
+		},
+		CustomizeDiff: resourceDedicatedServerVirtualMacCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of your dedicated server.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "ovh",
+				Description: "Type of the virtual MAC: ovh or vmware",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateDedicatedServerVirtualMacType(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"virtual_addresses": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "Failover IPs attached to the virtual MAC",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Failover IP",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := helpers.ValidateIpV4(v.(string))
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"virtual_machine_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the virtual machine using the IP",
+						},
+					},
+				},
+			},
+
+			//Computed
+			"mac_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The virtual MAC address",
+			},
+		},
+	}
+}
+
+func resourceDedicatedServerVirtualMacImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not service_name/mac_address formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("service_name", splitId[0])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+// resourceDedicatedServerVirtualMacCustomizeDiff replaces the virtual MAC when
+// an update would detach all its addresses: the virtual MAC is deleted along
+// with its last address, and the one created again has another MAC address.
+func resourceDedicatedServerVirtualMacCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("virtual_addresses") || !d.NewValueKnown("virtual_addresses") {
+		return nil
+	}
+
+	o, n := d.GetChange("virtual_addresses")
+
+	oldByIp := map[string]DedicatedServerVirtualAddress{}
+	for _, address := range dedicatedServerVirtualAddressesFromSet(o.(*schema.Set)) {
+		oldByIp[address.IpAddress] = address
+	}
+
+	// the virtual MAC is kept as long as an address is added,
+	// or an address is left untouched
+	for _, address := range dedicatedServerVirtualAddressesFromSet(n.(*schema.Set)) {
+		oldAddress, ok := oldByIp[address.IpAddress]
+		if !ok || oldAddress.VirtualMachineName == address.VirtualMachineName {
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] All the addresses of virtual MAC %s are renamed, it has to be replaced", d.Id())
+	return d.ForceNew("virtual_addresses")
+}
+
+func resourceDedicatedServerVirtualMacCreate(d *schema.ResourceData, meta interface{}) error {
+	for _, address := range dedicatedServerVirtualAddressesFromSet(d.Get("virtual_addresses").(*schema.Set)) {
+		if err := addDedicatedServerVirtualAddress(d, meta, address); err != nil {
+			return err
+		}
+	}
+
+	return resourceDedicatedServerVirtualMacRead(d, meta)
+}
+
+func resourceDedicatedServerVirtualMacRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/virtualMac/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	virtualMac := &DedicatedServerVirtualMac{}
+	if err := config.OVHClient.Get(endpoint, virtualMac); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	addresses, err := getDedicatedServerVirtualAddresses(serviceName, virtualMac.MacAddress, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	mapped := make([]interface{}, len(addresses))
+	for i, address := range addresses {
+		mapped[i] = address.ToMap()
+	}
+
+	d.Set("mac_address", virtualMac.MacAddress)
+	d.Set("type", virtualMac.Type)
+	d.Set("virtual_addresses", mapped)
+
+	return nil
+}
+
+func resourceDedicatedServerVirtualMacUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("virtual_addresses") {
+		o, n := d.GetChange("virtual_addresses")
+
+		oldByIp := map[string]DedicatedServerVirtualAddress{}
+		for _, address := range dedicatedServerVirtualAddressesFromSet(o.(*schema.Set)) {
+			oldByIp[address.IpAddress] = address
+		}
+		newByIp := map[string]DedicatedServerVirtualAddress{}
+		for _, address := range dedicatedServerVirtualAddressesFromSet(n.(*schema.Set)) {
+			newByIp[address.IpAddress] = address
+		}
+
+		// add the new addresses first, so that the virtual MAC
+		// is not deleted along with its last address
+		for ip, address := range newByIp {
+			if _, ok := oldByIp[ip]; !ok {
+				if err := addDedicatedServerVirtualAddress(d, meta, address); err != nil {
+					return err
+				}
+			}
+		}
+
+		for ip, address := range oldByIp {
+			newAddress, ok := newByIp[ip]
+			if ok && newAddress.VirtualMachineName == address.VirtualMachineName {
+				continue
+			}
+
+			if err := deleteDedicatedServerVirtualAddress(d, meta, ip); err != nil {
+				return err
+			}
+
+			// virtual machine names can't be updated,
+			// the address is attached again
+			if ok {
+				if err := addDedicatedServerVirtualAddress(d, meta, newAddress); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return resourceDedicatedServerVirtualMacRead(d, meta)
+}
+
+func resourceDedicatedServerVirtualMacDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	// addresses may have been detached out of band, only the remaining
+	// ones are deleted
+	addresses, err := getDedicatedServerVirtualAddresses(serviceName, d.Id(), config.OVHClient)
+	if err != nil {
+		return err
+	}
+	if addresses == nil {
+		log.Printf("[WARN] Virtual MAC %s on %s is already deleted", d.Id(), serviceName)
+	}
+
+	// the virtual MAC is deleted along with its last address
+	for _, address := range addresses {
+		if err := deleteDedicatedServerVirtualAddress(d, meta, address.IpAddress); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dedicatedServerVirtualAddressesFromSet(set *schema.Set) []DedicatedServerVirtualAddress {
+	addresses := []DedicatedServerVirtualAddress{}
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		addresses = append(addresses, DedicatedServerVirtualAddress{
+			IpAddress:          m["ip_address"].(string),
+			VirtualMachineName: m["virtual_machine_name"].(string),
+		})
+	}
+	return addresses
+}
+
+// addDedicatedServerVirtualAddress attaches an address to the virtual MAC
+// of the resource. The virtual MAC is created if it doesn't exist yet,
+// or doesn't exist anymore because its last address was deleted.
+func addDedicatedServerVirtualAddress(d *schema.ResourceData, meta interface{}, address DedicatedServerVirtualAddress) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	exists := false
+	if d.Id() != "" {
+		endpoint := fmt.Sprintf(
+			"/dedicated/server/%s/virtualMac/%s",
+			url.PathEscape(serviceName),
+			url.PathEscape(d.Id()),
+		)
+
+		if err := config.OVHClient.Get(endpoint, &DedicatedServerVirtualMac{}); err == nil {
+			exists = true
+		} else if errOvh, ok := err.(*ovh.APIError); !ok || errOvh.Code != 404 {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+	}
+
+	task := &DedicatedServerTask{}
+	if exists {
+		endpoint := fmt.Sprintf(
+			"/dedicated/server/%s/virtualMac/%s/virtualAddress",
+			url.PathEscape(serviceName),
+			url.PathEscape(d.Id()),
+		)
+
+		if err := config.OVHClient.Post(endpoint, address, task); err != nil {
+			return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, address, err)
+		}
+	} else {
+		endpoint := fmt.Sprintf(
+			"/dedicated/server/%s/virtualMac",
+			url.PathEscape(serviceName),
+		)
+
+		opts := &DedicatedServerVirtualMacCreateOpts{
+			IpAddress:          address.IpAddress,
+			Type:               d.Get("type").(string),
+			VirtualMachineName: address.VirtualMachineName,
+		}
+		if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+			return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+	}
+
+	if err := waitForDedicatedServerTask(serviceName, task, config.OVHClient); err != nil {
+		return err
+	}
+
+	if !exists {
+		// the task doesn't return the virtual MAC, look for the one
+		// the address is now attached to
+		macAddress, err := findDedicatedServerVirtualMac(serviceName, address.IpAddress, config.OVHClient)
+		if err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Virtual MAC %s created on %s for %s", macAddress, serviceName, address.IpAddress)
+		d.SetId(macAddress)
+	}
+
+	return nil
+}
+
+func deleteDedicatedServerVirtualAddress(d *schema.ResourceData, meta interface{}, ipAddress string) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/virtualMac/%s/virtualAddress/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+		url.PathEscape(ipAddress),
+	)
+
+	task := &DedicatedServerTask{}
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	return waitForDedicatedServerTask(serviceName, task, config.OVHClient)
+}
+
+// getDedicatedServerVirtualAddresses returns the addresses attached to a
+// virtual MAC, nil when the virtual MAC doesn't exist.
+func getDedicatedServerVirtualAddresses(serviceName, macAddress string, c *ovh.Client) ([]DedicatedServerVirtualAddress, error) {
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/virtualMac/%s/virtualAddress",
+		url.PathEscape(serviceName),
+		url.PathEscape(macAddress),
+	)
+
+	ips := []string{}
+	if err := c.Get(endpoint, &ips); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	addresses := make([]DedicatedServerVirtualAddress, len(ips))
+	for i, ip := range ips {
+		endpoint := fmt.Sprintf(
+			"/dedicated/server/%s/virtualMac/%s/virtualAddress/%s",
+			url.PathEscape(serviceName),
+			url.PathEscape(macAddress),
+			url.PathEscape(ip),
+		)
+
+		if err := c.Get(endpoint, &addresses[i]); err != nil {
+			return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+	}
+
+	return addresses, nil
+}
+
+func findDedicatedServerVirtualMac(serviceName, ipAddress string, c *ovh.Client) (string, error) {
+	endpoint := fmt.Sprintf(
+		"/dedicated/server/%s/virtualMac",
+		url.PathEscape(serviceName),
+	)
+
+	macAddresses := []string{}
+	if err := c.Get(endpoint, &macAddresses); err != nil {
+		return "", fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	for _, macAddress := range macAddresses {
+		addresses, err := getDedicatedServerVirtualAddresses(serviceName, macAddress, c)
+		if err != nil {
+			return "", err
+		}
+		for _, address := range addresses {
+			if address.IpAddress == ipAddress {
+				return macAddress, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("No virtual MAC found on %s for %s", serviceName, ipAddress)
+}
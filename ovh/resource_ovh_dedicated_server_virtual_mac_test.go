@@ -0,0 +1,73 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDedicatedServerVirtualMac_basic(t *testing.T) {
+	serviceName := os.Getenv("OVH_DEDICATED_SERVER")
+	ip := os.Getenv("OVH_DEDICATED_SERVER_FAILOVER_IP")
+	vmName := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDedicatedServerVirtualMac(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerVirtualMacConfig, serviceName, ip, vmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"ovh_dedicated_server_virtual_mac.vmac", "mac_address"),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_virtual_mac.vmac", "type", "ovh"),
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_virtual_mac.vmac", "virtual_addresses.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDedicatedServerVirtualMacConfig, serviceName, ip, vmName+"-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_dedicated_server_virtual_mac.vmac", "virtual_addresses.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ovh_dedicated_server_virtual_mac.vmac",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDedicatedServerVirtualMacImportId("ovh_dedicated_server_virtual_mac.vmac"),
+			},
+		},
+	})
+}
+
+func testAccDedicatedServerVirtualMacImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		vmac, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("ovh_dedicated_server_virtual_mac not found: %s", resourceName)
+		}
+		return fmt.Sprintf(
+			"%s/%s",
+			vmac.Primary.Attributes["service_name"],
+			vmac.Primary.ID,
+		), nil
+	}
+}
+
+const testAccDedicatedServerVirtualMacConfig = `
+resource "ovh_dedicated_server_virtual_mac" "vmac" {
+  service_name = "%s"
+
+  virtual_addresses {
+    ip_address           = "%s"
+    virtual_machine_name = "%s"
+  }
+}
+`
@@ -0,0 +1,164 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDomainDsRecords() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainDsRecordsCreateOrUpdate,
+		Read:   resourceDomainDsRecordsRead,
+		Update: resourceDomainDsRecordsCreateOrUpdate,
+		Delete: resourceDomainDsRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDomainDsRecordsImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The domain name registered at OVH",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ds_records": {
+				Type:        schema.TypeSet,
+				Description: "DS records to publish at the registry",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": {
+							Type:        schema.TypeInt,
+							Description: "Algorithm of the DNSKEY",
+							Required:    true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := helpers.ValidateDnssecAlgorithm(v.(int))
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"flags": {
+							Type:        schema.TypeInt,
+							Description: "Flags of the DNSKEY (256 for ZSK, 257 for KSK)",
+							Required:    true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := helpers.ValidateDnssecFlags(v.(int))
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"public_key": {
+							Type:        schema.TypeString,
+							Description: "Public key of the DNSKEY",
+							Required:    true,
+						},
+						"tag": {
+							Type:        schema.TypeInt,
+							Description: "Key tag of the DNSKEY",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDomainDsRecordsImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("domain", d.Id())
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDomainDsRecordsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+	opts := (&DomainDsRecordsUpdateOpts{}).FromResource(d)
+
+	if err := updateDomainDsRecords(domain, opts, config); err != nil {
+		return err
+	}
+
+	d.SetId(domain)
+
+	return resourceDomainDsRecordsRead(d, meta)
+}
+
+func resourceDomainDsRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/%s/dsRecord",
+		url.PathEscape(domain),
+	)
+
+	ids := []int64{}
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	dsRecords := []map[string]interface{}{}
+	for _, id := range ids {
+		dsRecord := &DomainDsRecord{}
+		endpoint := fmt.Sprintf(
+			"/domain/%s/dsRecord/%d",
+			url.PathEscape(domain),
+			id,
+		)
+
+		if err := config.OVHClient.Get(endpoint, dsRecord); err != nil {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+
+		log.Printf("[DEBUG] Read %s on domain %s", dsRecord, domain)
+		dsRecords = append(dsRecords, dsRecord.ToMap())
+	}
+
+	d.Set("ds_records", dsRecords)
+
+	return nil
+}
+
+func resourceDomainDsRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	opts := &DomainDsRecordsUpdateOpts{Keys: []DomainDsRecordKey{}}
+	if err := updateDomainDsRecords(domain, opts, config); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func updateDomainDsRecords(domain string, opts *DomainDsRecordsUpdateOpts, config *Config) error {
+	endpoint := fmt.Sprintf(
+		"/domain/%s/dsRecord",
+		url.PathEscape(domain),
+	)
+
+	task := &DomainTask{}
+	if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForDomainTask(domain, task, config.OVHClient); err != nil {
+		return fmt.Errorf("Error waiting for DS records update on domain %s: %s", domain, err)
+	}
+
+	return nil
+}
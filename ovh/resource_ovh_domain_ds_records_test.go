@@ -0,0 +1,49 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// OVH_DOMAIN must use external name servers for the registry
+// to accept DS records.
+func TestAccDomainDsRecords_basic(t *testing.T) {
+	domain := os.Getenv("OVH_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomainName(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainDsRecordsConfig, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_ds_records.ds", "domain", domain),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_ds_records.ds", "ds_records.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ovh_domain_ds_records.ds",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccDomainDsRecordsConfig = `
+resource "ovh_domain_ds_records" "ds" {
+  domain = "%s"
+
+  ds_records {
+    algorithm  = 8
+    flags      = 257
+    public_key = "AwEAAaz/tAm8yTn4Mfeh5eyI96WSVexTBAvkMgJzkKTOiW1vkIbzxeF3+/4RgWOq7HrxRixHlFlExOLAJr5emLvN7SWXgnLh4+B5xQlNVz8Og8kvArMtNROxVQuCaSnIDdD5LKyWbRd2n9WGe2R8PzgCmr3EgVLrjyBxWezF0jLHwVN8efS3rCj/EWgvIWgb9tarpVUDK/b58Da+sqqls3eNbuv7pr+eoZG+SrDK6nWeL3c6H5Apxz7LjVc1uTIdsIXxuOLYA4/ilBmSVIzuDWfdRUfhHdY6+cn8HFRm+2hM8AnXGXws9555KrUB5qihylGa8subX2Nn6UwNR1AkUTV74bU="
+    tag        = 20326
+  }
+}
+`
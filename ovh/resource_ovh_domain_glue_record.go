@@ -0,0 +1,171 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDomainGlueRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainGlueRecordCreate,
+		Read:   resourceDomainGlueRecordRead,
+		Update: resourceDomainGlueRecordUpdate,
+		Delete: resourceDomainGlueRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDomainGlueRecordImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The domain name registered at OVH",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "Host name of the glue record, within the domain",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ips": {
+				Type:        schema.TypeSet,
+				Description: "IPs of the glue record",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						err := helpers.ValidateIp(v.(string))
+						if err != nil {
+							errors = append(errors, err)
+						}
+						return
+					},
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceDomainGlueRecordImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not domain/host formatted")
+	}
+	d.Set("domain", splitId[0])
+	d.Set("host", splitId[1])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDomainGlueRecordCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	opts, err := (&DomainGlueRecordCreateOpts{}).FromResource(d)
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf(
+		"/domain/%s/glueRecord",
+		url.PathEscape(domain),
+	)
+
+	task := &DomainTask{}
+	if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForDomainTask(domain, task, config.OVHClient); err != nil {
+		return fmt.Errorf("Error waiting for glue record %s creation on domain %s: %s", opts.Host, domain, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", domain, opts.Host))
+
+	return resourceDomainGlueRecordRead(d, meta)
+}
+
+func resourceDomainGlueRecordRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+	host := d.Get("host").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/%s/glueRecord/%s",
+		url.PathEscape(domain),
+		url.PathEscape(host),
+	)
+
+	glueRecord := &DomainGlueRecord{}
+	if err := config.OVHClient.Get(endpoint, glueRecord); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", domain, glueRecord.Host))
+	d.Set("host", glueRecord.Host)
+	d.Set("ips", glueRecord.Ips)
+
+	return nil
+}
+
+func resourceDomainGlueRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+	host := d.Get("host").(string)
+
+	opts, err := (&DomainGlueRecordUpdateOpts{}).FromResource(d)
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf(
+		"/domain/%s/glueRecord/%s/update",
+		url.PathEscape(domain),
+		url.PathEscape(host),
+	)
+
+	task := &DomainTask{}
+	if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForDomainTask(domain, task, config.OVHClient); err != nil {
+		return fmt.Errorf("Error waiting for glue record %s update on domain %s: %s", host, domain, err)
+	}
+
+	return resourceDomainGlueRecordRead(d, meta)
+}
+
+func resourceDomainGlueRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+	host := d.Get("host").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/%s/glueRecord/%s",
+		url.PathEscape(domain),
+		url.PathEscape(host),
+	)
+
+	task := &DomainTask{}
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForDomainTask(domain, task, config.OVHClient); err != nil {
+		return fmt.Errorf("Error waiting for glue record %s deletion on domain %s: %s", host, domain, err)
+	}
+
+	d.SetId("")
+	return nil
+}
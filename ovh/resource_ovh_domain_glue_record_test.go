@@ -0,0 +1,76 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDomainGlueRecord_basic(t *testing.T) {
+	domain := os.Getenv("OVH_DOMAIN")
+	host := fmt.Sprintf("%s.%s", acctest.RandomWithPrefix(test_prefix), domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDomainName(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDomainGlueRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainGlueRecordConfig, domain, host, `"192.0.2.1"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_glue_record.glue", "host", host),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_glue_record.glue", "ips.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDomainGlueRecordConfig, domain, host, `"192.0.2.1", "192.0.2.2"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_glue_record.glue", "ips.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ovh_domain_glue_record.glue",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDomainGlueRecordDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_domain_glue_record" {
+			continue
+		}
+
+		glueRecord := &DomainGlueRecord{}
+		endpoint := fmt.Sprintf(
+			"/domain/%s/glueRecord/%s",
+			rs.Primary.Attributes["domain"],
+			rs.Primary.Attributes["host"],
+		)
+
+		if err := config.OVHClient.Get(endpoint, glueRecord); err == nil {
+			return fmt.Errorf("Glue record %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccDomainGlueRecordConfig = `
+resource "ovh_domain_glue_record" "glue" {
+  domain = "%s"
+  host   = "%s"
+  ips    = [%s]
+}
+`
@@ -0,0 +1,224 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDomainNameServers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainNameServersCreateOrUpdate,
+		Read:   resourceDomainNameServersRead,
+		Update: resourceDomainNameServersCreateOrUpdate,
+		Delete: resourceDomainNameServersDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDomainNameServersImportState,
+		},
+		CustomizeDiff: resourceDomainNameServersCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The domain name registered at OVH",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Name servers type: hosted or external",
+				Required:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{"hosted", "external"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"servers": {
+				Type:        schema.TypeSet,
+				Description: "Name servers of the domain. Required if type is external, not allowed if type is hosted",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Description: "Host name of the name server",
+							Required:    true,
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Description: "IP of the name server, used as glue when the host is within the domain",
+							Optional:    true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := helpers.ValidateIp(v.(string))
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDomainNameServersImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("domain", d.Id())
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+// resourceDomainNameServersCustomizeDiff rejects name servers set along with
+// the hosted type: OVH picks the name servers of hosted domains, the
+// configured ones would never be applied.
+func resourceDomainNameServersCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") || d.Get("type").(string) != "hosted" {
+		return nil
+	}
+
+	if d.HasChange("servers") && d.NewValueKnown("servers") && d.Get("servers").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("servers can't be set if type is hosted, OVH sets the name servers of hosted domains")
+	}
+
+	return nil
+}
+
+func resourceDomainNameServersCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+	nameServerType := d.Get("type").(string)
+
+	_, serversSet := d.GetOk("servers")
+	if nameServerType == "external" && !serversSet {
+		return fmt.Errorf("servers must be set if type is external")
+	}
+
+	ds, err := getDomain(domain, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	// no update task is created when the type is already the wanted one
+	if ds.NameServerType != nameServerType {
+		endpoint := fmt.Sprintf(
+			"/domain/%s",
+			url.PathEscape(domain),
+		)
+
+		// the update task is not returned by the API, remember the
+		// existing ones to find it afterwards
+		knownTaskIds, err := getDomainTaskIds(domain, "DomainDnsUpdate", config.OVHClient)
+		if err != nil {
+			return err
+		}
+
+		opts := &DomainUpdateOpts{NameServerType: &nameServerType}
+		if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+			return fmt.Errorf("Error calling PUT %s:\n\t %q", endpoint, err)
+		}
+
+		if err := waitForDomainNewTasks(domain, "DomainDnsUpdate", knownTaskIds, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	if nameServerType == "external" && (d.IsNewResource() || d.HasChange("servers")) {
+		endpoint := fmt.Sprintf(
+			"/domain/%s/nameServers/update",
+			url.PathEscape(domain),
+		)
+
+		opts := (&DomainNameServersUpdateOpts{}).FromResource(d)
+		task := &DomainTask{}
+		if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+			return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+
+		if err := waitForDomainTask(domain, task, config.OVHClient); err != nil {
+			return fmt.Errorf("Error waiting for name servers update on domain %s: %s", domain, err)
+		}
+	}
+
+	d.SetId(domain)
+
+	return resourceDomainNameServersRead(d, meta)
+}
+
+func resourceDomainNameServersRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	ds, err := getDomain(domain, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	d.Set("type", ds.NameServerType)
+
+	endpoint := fmt.Sprintf(
+		"/domain/%s/nameServer",
+		url.PathEscape(domain),
+	)
+
+	ids := []int64{}
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	servers := []map[string]interface{}{}
+	for _, id := range ids {
+		ns := &DomainNameServer{}
+		endpoint := fmt.Sprintf(
+			"/domain/%s/nameServer/%d",
+			url.PathEscape(domain),
+			id,
+		)
+
+		if err := config.OVHClient.Get(endpoint, ns); err != nil {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+
+		if ns.ToDelete {
+			log.Printf("[DEBUG] Name server %s on domain %s is being deleted", ns.Host, domain)
+			continue
+		}
+		servers = append(servers, ns.ToMap())
+	}
+
+	d.Set("servers", servers)
+
+	return nil
+}
+
+func resourceDomainNameServersDelete(d *schema.ResourceData, meta interface{}) error {
+	// name servers can't be removed from a domain, just forget about them
+	d.SetId("")
+	return nil
+}
+
+func getDomain(domain string, c *ovh.Client) (*Domain, error) {
+	ds := &Domain{}
+	endpoint := fmt.Sprintf(
+		"/domain/%s",
+		url.PathEscape(domain),
+	)
+
+	if err := c.Get(endpoint, ds); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return ds, nil
+}
@@ -0,0 +1,58 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainNameServers_basic(t *testing.T) {
+	domain := os.Getenv("OVH_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomainName(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainNameServersConfig_external, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_name_servers.ns", "type", "external"),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_name_servers.ns", "servers.#", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDomainNameServersConfig_hosted, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_name_servers.ns", "type", "hosted"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDomainNameServersConfig_external = `
+resource "ovh_domain_name_servers" "ns" {
+  domain = "%s"
+  type   = "external"
+
+  servers {
+    host = "dns200.anycast.me"
+  }
+
+  servers {
+    host = "ns200.anycast.me"
+  }
+}
+`
+
+const testAccDomainNameServersConfig_hosted = `
+resource "ovh_domain_name_servers" "ns" {
+  domain = "%s"
+  type   = "hosted"
+}
+`
@@ -0,0 +1,189 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDomainSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainSettingsCreateOrUpdate,
+		Read:   resourceDomainSettingsRead,
+		Update: resourceDomainSettingsCreateOrUpdate,
+		Delete: resourceDomainSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDomainSettingsImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The domain name registered at OVH",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"transfer_lock": {
+				Type:        schema.TypeBool,
+				Description: "Lock the domain against transfers",
+				Optional:    true,
+				Computed:    true,
+			},
+			"automatic_renew": {
+				Type:        schema.TypeBool,
+				Description: "Renew the domain automatically",
+				Optional:    true,
+				Computed:    true,
+			},
+
+			// Computed
+			"transfer_lock_status": {
+				Type:        schema.TypeString,
+				Description: "Transfer lock status: locked, locking, unavailable, unlocked or unlocking",
+				Computed:    true,
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Description: "Expiration date of the domain",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDomainSettingsImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("domain", d.Id())
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDomainSettingsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	if v, ok := d.GetOkExists("transfer_lock"); ok && (d.IsNewResource() || d.HasChange("transfer_lock")) {
+		status := "unlocked"
+		if v.(bool) {
+			status = "locked"
+		}
+
+		endpoint := fmt.Sprintf(
+			"/domain/%s",
+			url.PathEscape(domain),
+		)
+
+		opts := &DomainUpdateOpts{TransferLockStatus: &status}
+		if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+			return fmt.Errorf("Error calling PUT %s:\n\t %q", endpoint, err)
+		}
+
+		if err := waitForDomainTransferLockStatus(domain, status, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOkExists("automatic_renew"); ok && (d.IsNewResource() || d.HasChange("automatic_renew")) {
+		endpoint := fmt.Sprintf(
+			"/domain/%s/serviceInfos",
+			url.PathEscape(domain),
+		)
+
+		serviceInfos := &ServiceInfos{}
+		if err := config.OVHClient.Get(endpoint, serviceInfos); err != nil {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+
+		renew := &ServiceInfosRenew{}
+		if serviceInfos.Renew != nil {
+			renew = serviceInfos.Renew
+		}
+		renew.Automatic = v.(bool)
+
+		opts := &ServiceInfosUpdateOpts{Renew: renew}
+		if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+			return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+	}
+
+	d.SetId(domain)
+
+	return resourceDomainSettingsRead(d, meta)
+}
+
+func resourceDomainSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	domain := d.Get("domain").(string)
+
+	ds, err := getDomain(domain, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	d.Set("transfer_lock_status", ds.TransferLockStatus)
+	d.Set("transfer_lock", ds.TransferLockStatus == "locked" || ds.TransferLockStatus == "locking")
+
+	endpoint := fmt.Sprintf(
+		"/domain/%s/serviceInfos",
+		url.PathEscape(domain),
+	)
+
+	serviceInfos := &ServiceInfos{}
+	if err := config.OVHClient.Get(endpoint, serviceInfos); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	d.Set("expiration", serviceInfos.Expiration)
+	if serviceInfos.Renew != nil {
+		d.Set("automatic_renew", serviceInfos.Renew.Automatic)
+	}
+
+	return nil
+}
+
+func resourceDomainSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	// settings are left as is on the domain, just forget about them
+	d.SetId("")
+	return nil
+}
+
+func waitForDomainTransferLockStatus(domain, target string, c *ovh.Client) error {
+	// the domain may still report its previous status
+	// right after the change is requested
+	pending := []string{"locking", "unlocking"}
+	switch target {
+	case "locked":
+		pending = append(pending, "unlocked")
+	case "unlocked":
+		pending = append(pending, "locked")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			ds, err := getDomain(domain, c)
+			if err != nil {
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] Transfer lock status on domain %s: %s", domain, ds.TransferLockStatus)
+			return ds, ds.TransferLockStatus, nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for domain %s to be %s: %s", domain, target, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainSettings_basic(t *testing.T) {
+	domain := os.Getenv("OVH_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomainName(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainSettingsConfig, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_settings.settings", "transfer_lock", "false"),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_settings.settings", "transfer_lock_status", "unlocked"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDomainSettingsConfig, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_settings.settings", "transfer_lock", "true"),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_settings.settings", "transfer_lock_status", "locked"),
+				),
+			},
+			{
+				ResourceName:      "ovh_domain_settings.settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccDomainSettingsConfig = `
+resource "ovh_domain_settings" "settings" {
+  domain          = "%s"
+  transfer_lock   = %v
+  automatic_renew = true
+}
+`
@@ -0,0 +1,225 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDomainZoneDnssec() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainZoneDnssecCreate,
+		Read:   resourceDomainZoneDnssecRead,
+		Delete: resourceDomainZoneDnssecDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDomainZoneDnssecImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the domain zone",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Computed
+			"status": {
+				Type:        schema.TypeString,
+				Description: "DNSSEC status of the zone",
+				Computed:    true,
+			},
+			"ds_records": {
+				Type:        schema.TypeList,
+				Description: "DS records published at the registry for the zone",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": {
+							Type:        schema.TypeInt,
+							Description: "Algorithm of the DNSKEY",
+							Computed:    true,
+						},
+						"flags": {
+							Type:        schema.TypeInt,
+							Description: "Flags of the DNSKEY (256 for ZSK, 257 for KSK)",
+							Computed:    true,
+						},
+						"public_key": {
+							Type:        schema.TypeString,
+							Description: "Public key of the DNSKEY",
+							Computed:    true,
+						},
+						"tag": {
+							Type:        schema.TypeInt,
+							Description: "Key tag of the DNSKEY",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDomainZoneDnssecImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone", d.Id())
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDomainZoneDnssecCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dnssec",
+		url.PathEscape(zone),
+	)
+
+	if err := config.OVHClient.Post(endpoint, nil, nil); err != nil {
+		return fmt.Errorf("Error calling POST %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForDomainZoneDnssecStatus(zone, "enabled", d.Timeout(schema.TimeoutCreate), config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId(zone)
+
+	return resourceDomainZoneDnssecRead(d, meta)
+}
+
+func resourceDomainZoneDnssecRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	dnssec, err := getDomainZoneDnssec(zone, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	if dnssec.Status == "disabled" || dnssec.Status == "disableInProgress" {
+		log.Printf("[WARN] DNSSEC is %s on zone %s, removing it from state", dnssec.Status, zone)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("status", dnssec.Status)
+
+	// DS records are only available when the zone is also a domain
+	// registered at OVH.
+	endpoint := fmt.Sprintf(
+		"/domain/%s/dsRecord",
+		url.PathEscape(zone),
+	)
+
+	ids := []int64{}
+	if err := config.OVHClient.Get(endpoint, &ids); err != nil {
+		if err.(*ovh.APIError).Code != 404 {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+		log.Printf("[DEBUG] Zone %s is not a domain registered at OVH. No DS record to read", zone)
+	}
+
+	dsRecords := make([]map[string]interface{}, len(ids))
+	for i, id := range ids {
+		dsRecord := &DomainDsRecord{}
+		endpoint := fmt.Sprintf(
+			"/domain/%s/dsRecord/%d",
+			url.PathEscape(zone),
+			id,
+		)
+
+		if err := config.OVHClient.Get(endpoint, dsRecord); err != nil {
+			return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+		dsRecords[i] = dsRecord.ToMap()
+	}
+
+	d.Set("ds_records", dsRecords)
+
+	return nil
+}
+
+func resourceDomainZoneDnssecDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dnssec",
+		url.PathEscape(zone),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForDomainZoneDnssecStatus(zone, "disabled", d.Timeout(schema.TimeoutDelete), config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getDomainZoneDnssec(zone string, c *ovh.Client) (*DomainZoneDnssec, error) {
+	dnssec := &DomainZoneDnssec{}
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dnssec",
+		url.PathEscape(zone),
+	)
+
+	if err := c.Get(endpoint, dnssec); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return dnssec, nil
+}
+
+func waitForDomainZoneDnssecStatus(zone, target string, timeout time.Duration, c *ovh.Client) error {
+	// the zone may still report its previous status
+	// right after the change is requested
+	pending := []string{"enableInProgress", "disableInProgress"}
+	switch target {
+	case "enabled":
+		pending = append(pending, "disabled")
+	case "disabled":
+		pending = append(pending, "enabled")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			dnssec, err := getDomainZoneDnssec(zone, c)
+			if err != nil {
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] DNSSEC status on zone %s: %s", zone, dnssec.Status)
+			return dnssec, dnssec.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DNSSEC on zone %s to be %s: %s", zone, target, err)
+	}
+
+	return nil
+}
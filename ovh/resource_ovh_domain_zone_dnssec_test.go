@@ -0,0 +1,63 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDomainZoneDnssec_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDomainZoneDnssecDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneDnssecConfig, zone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dnssec.dnssec", "zone", zone),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dnssec.dnssec", "status", "enabled"),
+				),
+			},
+			{
+				ResourceName:      "ovh_domain_zone_dnssec.dnssec",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDomainZoneDnssecDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_domain_zone_dnssec" {
+			continue
+		}
+
+		dnssec, err := getDomainZoneDnssec(rs.Primary.ID, config.OVHClient)
+		if err != nil {
+			return err
+		}
+
+		if dnssec.Status != "disabled" {
+			return fmt.Errorf("DNSSEC on zone %s is still %s", rs.Primary.ID, dnssec.Status)
+		}
+	}
+
+	return nil
+}
+
+const testAccDomainZoneDnssecConfig = `
+resource "ovh_domain_zone_dnssec" "dnssec" {
+  zone = "%s"
+}
+`
@@ -0,0 +1,170 @@
+package ovh
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDomainZoneDynHostLogin() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainZoneDynHostLoginCreate,
+		Read:   resourceDomainZoneDynHostLoginRead,
+		Update: resourceDomainZoneDynHostLoginUpdate,
+		Delete: resourceDomainZoneDynHostLoginDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDomainZoneDynHostLoginImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the domain zone",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"login_suffix": {
+				Type:        schema.TypeString,
+				Description: "Suffix of the login, the full login is zone-suffix",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Description: "Subdomain the login is allowed to update. Use * to allow all subdomains",
+				Required:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "Password of the login. Generated if not set",
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+			},
+
+			// Computed
+			"login": {
+				Type:        schema.TypeString,
+				Description: "Full login",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDomainZoneDynHostLoginImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not zone/login formatted")
+	}
+	zone := splitId[0]
+	login := splitId[1]
+	d.SetId(login)
+	d.Set("zone", zone)
+	// logins are formatted as zone-suffix
+	d.Set("login_suffix", strings.TrimPrefix(login, zone+"-"))
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDomainZoneDynHostLoginCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	if _, ok := d.GetOk("password"); !ok {
+		password, err := helpers.GeneratePassword(24)
+		if err != nil {
+			return err
+		}
+		d.Set("password", password)
+	}
+
+	opts := (&DomainZoneDynHostLoginCreateOpts{}).FromResource(d)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/login",
+		url.PathEscape(zone),
+	)
+
+	login := &DomainZoneDynHostLogin{}
+	if err := config.OVHClient.Post(endpoint, opts, login); err != nil {
+		return fmt.Errorf("Error calling POST %s for login suffix %s:\n\t %q", endpoint, opts.LoginSuffix, err)
+	}
+
+	d.SetId(login.Login)
+
+	return resourceDomainZoneDynHostLoginRead(d, meta)
+}
+
+func resourceDomainZoneDynHostLoginRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/login/%s",
+		url.PathEscape(zone),
+		url.PathEscape(d.Id()),
+	)
+
+	login := &DomainZoneDynHostLogin{}
+	if err := config.OVHClient.Get(endpoint, login); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("zone", login.Zone)
+	d.Set("login", login.Login)
+	d.Set("subdomain", login.SubDomain)
+
+	return nil
+}
+
+func resourceDomainZoneDynHostLoginUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/login/%s",
+		url.PathEscape(zone),
+		url.PathEscape(d.Id()),
+	)
+
+	if d.HasChange("subdomain") {
+		opts := &DomainZoneDynHostLoginUpdateOpts{SubDomain: d.Get("subdomain").(string)}
+		if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+			return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+	}
+
+	if d.HasChange("password") {
+		opts := &DomainZoneDynHostLoginPasswordOpts{Password: d.Get("password").(string)}
+		if err := config.OVHClient.Post(endpoint+"/changePassword", opts, nil); err != nil {
+			return fmt.Errorf("Error calling POST %s/changePassword:\n\t %q", endpoint, err)
+		}
+	}
+
+	return resourceDomainZoneDynHostLoginRead(d, meta)
+}
+
+func resourceDomainZoneDynHostLoginDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/login/%s",
+		url.PathEscape(zone),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,49 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainZoneDynHostLogin_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE")
+	suffix := acctest.RandString(8)
+	subdomain := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneDynHostLoginConfig, zone, suffix, subdomain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dynhost_login.login", "login", fmt.Sprintf("%s-%s", zone, suffix)),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dynhost_login.login", "subdomain", subdomain),
+					resource.TestCheckResourceAttrSet(
+						"ovh_domain_zone_dynhost_login.login", "password"),
+				),
+			},
+			{
+				ResourceName:            "ovh_domain_zone_dynhost_login.login",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/%s-%s", zone, zone, suffix),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+const testAccDomainZoneDynHostLoginConfig = `
+resource "ovh_domain_zone_dynhost_login" "login" {
+  zone         = "%s"
+  login_suffix = "%s"
+  subdomain    = "%s"
+}
+`
@@ -0,0 +1,162 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDomainZoneDynHostRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainZoneDynHostRecordCreate,
+		Read:   resourceDomainZoneDynHostRecordRead,
+		Update: resourceDomainZoneDynHostRecordUpdate,
+		Delete: resourceDomainZoneDynHostRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDomainZoneDynHostRecordImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the domain zone",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Description: "Subdomain of the DynHost record",
+				Optional:    true,
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "IPv4 the DynHost record points to",
+				Required:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"ttl": {
+				Type:        schema.TypeInt,
+				Description: "TTL of the DynHost record",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDomainZoneDynHostRecordImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not zone/record_id formatted")
+	}
+	d.SetId(splitId[1])
+	d.Set("zone", splitId[0])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDomainZoneDynHostRecordCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+	opts := (&DomainZoneDynHostRecordOpts{}).FromResource(d)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/record",
+		url.PathEscape(zone),
+	)
+
+	record := &DomainZoneDynHostRecord{}
+	if err := config.OVHClient.Post(endpoint, opts, record); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	d.SetId(strconv.FormatInt(record.Id, 10))
+
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		log.Printf("[WARN] OVH Domain zone refresh after DynHost record creation failed: %s", err)
+	}
+
+	return resourceDomainZoneDynHostRecordRead(d, meta)
+}
+
+func resourceDomainZoneDynHostRecordRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/record/%s",
+		url.PathEscape(zone),
+		url.PathEscape(d.Id()),
+	)
+
+	record := &DomainZoneDynHostRecord{}
+	if err := config.OVHClient.Get(endpoint, record); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("zone", record.Zone)
+	d.Set("subdomain", record.SubDomain)
+	d.Set("ip", record.Ip)
+	d.Set("ttl", record.Ttl)
+
+	return nil
+}
+
+func resourceDomainZoneDynHostRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+	opts := (&DomainZoneDynHostRecordOpts{}).FromResource(d)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/record/%s",
+		url.PathEscape(zone),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		log.Printf("[WARN] OVH Domain zone refresh after DynHost record update failed: %s", err)
+	}
+
+	return resourceDomainZoneDynHostRecordRead(d, meta)
+}
+
+func resourceDomainZoneDynHostRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/dynHost/record/%s",
+		url.PathEscape(zone),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		log.Printf("[WARN] OVH Domain zone refresh after DynHost record deletion failed: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
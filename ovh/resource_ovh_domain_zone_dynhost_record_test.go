@@ -0,0 +1,71 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDomainZoneDynHostRecord_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE")
+	subdomain := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDomain(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDomainZoneDynHostRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneDynHostRecordConfig, zone, subdomain, "192.0.2.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dynhost_record.dynhost", "subdomain", subdomain),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dynhost_record.dynhost", "ip", "192.0.2.10"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDomainZoneDynHostRecordConfig, zone, subdomain, "192.0.2.11"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_dynhost_record.dynhost", "ip", "192.0.2.11"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDomainZoneDynHostRecordDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ovh_domain_zone_dynhost_record" {
+			continue
+		}
+
+		record := &DomainZoneDynHostRecord{}
+		endpoint := fmt.Sprintf(
+			"/domain/zone/%s/dynHost/record/%s",
+			rs.Primary.Attributes["zone"],
+			rs.Primary.ID,
+		)
+
+		if err := config.OVHClient.Get(endpoint, record); err == nil {
+			return fmt.Errorf("DynHost record %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccDomainZoneDynHostRecordConfig = `
+resource "ovh_domain_zone_dynhost_record" "dynhost" {
+  zone      = "%s"
+  subdomain = "%s"
+  ip        = "%s"
+}
+`
@@ -3,6 +3,7 @@ package ovh
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"sort"
 	"strconv"
 	"strings"
@@ -36,10 +37,32 @@ func (r *OvhDomainZoneRecord) String() string {
 func resourceOvhDomainZoneRecordImportState(
 	d *schema.ResourceData,
 	meta interface{}) ([]*schema.ResourceData, error) {
+	provider := meta.(*Config)
 	givenId := d.Id()
+
+	// natural key import: zone/subdomain/fieldtype/target
+	if strings.Contains(givenId, "/") {
+		splitId := strings.SplitN(givenId, "/", 4)
+		if len(splitId) != 4 {
+			return nil, fmt.Errorf("Import Id is not zone/subdomain/fieldtype/target formatted")
+		}
+		zone := splitId[0]
+
+		record, err := ovhDomainZoneRecordSearch(provider.OVHClient, zone, splitId[1], splitId[2], splitId[3])
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(strconv.FormatInt(record.Id, 10))
+		d.Set("zone", zone)
+		results := make([]*schema.ResourceData, 1)
+		results[0] = d
+		return results, nil
+	}
+
 	splitId := strings.SplitN(givenId, ".", 2)
 	if len(splitId) != 2 {
-		return nil, fmt.Errorf("Import Id is not OVH_ID.zone formatted")
+		return nil, fmt.Errorf("Import Id is not OVH_ID.zone or zone/subdomain/fieldtype/target formatted")
 	}
 	d.SetId(splitId[0])
 	d.Set("zone", splitId[1])
@@ -268,3 +291,45 @@ func ovhDomainZoneRecord(client *ovh.Client, zone string, id string, retry bool)
 
 	return rec, nil
 }
+
+// ovhDomainZoneRecordSearch returns the only record of the zone matching
+// the given subdomain, fieldtype and target.
+func ovhDomainZoneRecordSearch(client *ovh.Client, zone, subDomain, fieldType, target string) (*OvhDomainZoneRecord, error) {
+	query := url.Values{}
+	query.Set("fieldType", fieldType)
+	if subDomain != "" {
+		query.Set("subDomain", subDomain)
+	}
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/record?%s",
+		url.PathEscape(zone),
+		query.Encode(),
+	)
+
+	ids := []int64{}
+	if err := client.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	matches := []*OvhDomainZoneRecord{}
+	for _, id := range ids {
+		record, err := ovhDomainZoneRecord(client, zone, strconv.FormatInt(id, 10), false)
+		if err != nil {
+			return nil, err
+		}
+
+		if record.SubDomain == subDomain && record.FieldType == fieldType && record.Target == target {
+			matches = append(matches, record)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("No %s record found on zone %s for subdomain %q and target %q", fieldType, zone, subDomain, target)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d %s records found on zone %s for subdomain %q and target %q: %v", len(matches), fieldType, zone, subDomain, target, matches)
+	}
+}
@@ -3,9 +3,12 @@ package ovh
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ovh/go-ovh/ovh"
 )
 
 type OvhDomainZoneRedirection struct {
@@ -25,6 +28,9 @@ func resourceOvhDomainZoneRedirection() *schema.Resource {
 		Read:   resourceOvhDomainZoneRedirectionRead,
 		Update: resourceOvhDomainZoneRedirectionUpdate,
 		Delete: resourceOvhDomainZoneRedirectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOvhDomainZoneRedirectionImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"zone": {
@@ -60,6 +66,43 @@ func resourceOvhDomainZoneRedirection() *schema.Resource {
 	}
 }
 
+func resourceOvhDomainZoneRedirectionImportState(
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	provider := meta.(*Config)
+	givenId := d.Id()
+
+	// natural key import: zone/subdomain/type/target
+	if strings.Contains(givenId, "/") {
+		splitId := strings.SplitN(givenId, "/", 4)
+		if len(splitId) != 4 {
+			return nil, fmt.Errorf("Import Id is not zone/subdomain/type/target formatted")
+		}
+		zone := splitId[0]
+
+		redirection, err := ovhDomainZoneRedirectionSearch(provider.OVHClient, zone, splitId[1], splitId[2], splitId[3])
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(strconv.Itoa(redirection.Id))
+		d.Set("zone", zone)
+		results := make([]*schema.ResourceData, 1)
+		results[0] = d
+		return results, nil
+	}
+
+	splitId := strings.SplitN(givenId, ".", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not OVH_ID.zone or zone/subdomain/type/target formatted")
+	}
+	d.SetId(splitId[0])
+	d.Set("zone", splitId[1])
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
 func resourceOvhDomainZoneRedirectionCreate(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*Config)
 
@@ -185,3 +228,51 @@ func resourceOvhDomainZoneRedirectionDelete(d *schema.ResourceData, meta interfa
 
 	return nil
 }
+
+// ovhDomainZoneRedirectionSearch returns the only redirection of the zone
+// matching the given subdomain, type and target.
+func ovhDomainZoneRedirectionSearch(client *ovh.Client, zone, subDomain, redirectionType, target string) (*OvhDomainZoneRedirection, error) {
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/redirection",
+		url.PathEscape(zone),
+	)
+	if subDomain != "" {
+		endpoint = fmt.Sprintf("%s?subDomain=%s", endpoint, url.QueryEscape(subDomain))
+	}
+
+	ids := []int{}
+	if err := client.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	matches := []*OvhDomainZoneRedirection{}
+	for _, id := range ids {
+		redirection := &OvhDomainZoneRedirection{}
+		endpoint := fmt.Sprintf(
+			"/domain/zone/%s/redirection/%d",
+			url.PathEscape(zone),
+			id,
+		)
+
+		if err := client.Get(endpoint, redirection); err != nil {
+			return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+
+		if redirection.SubDomain == subDomain && redirection.Type == redirectionType && redirection.Target == target {
+			matches = append(matches, redirection)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("No %s redirection found on zone %s for subdomain %q and target %q", redirectionType, zone, subDomain, target)
+	case 1:
+		return matches[0], nil
+	default:
+		ids := []int{}
+		for _, redirection := range matches {
+			ids = append(ids, redirection.Id)
+		}
+		return nil, fmt.Errorf("%d %s redirections found on zone %s for subdomain %q and target %q: %v", len(matches), redirectionType, zone, subDomain, target, ids)
+	}
+}
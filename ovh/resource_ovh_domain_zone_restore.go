@@ -0,0 +1,94 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceDomainZoneRestore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainZoneRestoreCreate,
+		Read:   resourceDomainZoneRestoreRead,
+		Delete: resourceDomainZoneRestoreDelete,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the domain zone",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Description: "Creation date of the zone version to restore",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateRFC3339Date(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"keepers": {
+				Type:        schema.TypeList,
+				Description: "List of values tracked to trigger a new restore",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			// Computed
+			"task_id": {
+				Type:        schema.TypeInt,
+				Description: "Id of the restore task",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDomainZoneRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+	creationDate := d.Get("creation_date").(string)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/history/%s/restore",
+		url.PathEscape(zone),
+		url.PathEscape(creationDate),
+	)
+
+	task := &DomainTask{}
+	if err := config.OVHClient.Post(endpoint, nil, task); err != nil {
+		return fmt.Errorf("Error calling POST %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForDomainZoneTask(zone, task, config.OVHClient); err != nil {
+		return fmt.Errorf("Error waiting for zone %s to be restored to %s: %s", zone, creationDate, err)
+	}
+
+	log.Printf("[DEBUG] Zone %s restored to version %s", zone, creationDate)
+
+	d.SetId(fmt.Sprintf("%s/%s", zone, creationDate))
+	d.Set("task_id", task.Id)
+
+	return nil
+}
+
+func resourceDomainZoneRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceDomainZoneRestoreDelete(d *schema.ResourceData, meta interface{}) error {
+	// a restore can't be undone, just forget about it
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,43 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainZoneRestore_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneRestoreConfig, zone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_restore.restore", "zone", zone),
+					resource.TestCheckResourceAttrPair(
+						"ovh_domain_zone_restore.restore", "creation_date",
+						"data.ovh_domain_zone_history.history", "versions.0.creation_date"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_domain_zone_restore.restore", "task_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDomainZoneRestoreConfig = `
+data "ovh_domain_zone_history" "history" {
+  zone = "%s"
+}
+
+resource "ovh_domain_zone_restore" "restore" {
+  zone          = data.ovh_domain_zone_history.history.zone
+  creation_date = data.ovh_domain_zone_history.history.versions.0.creation_date
+}
+`
@@ -0,0 +1,147 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceDomainZoneSoa() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDomainZoneSoaCreateOrUpdate,
+		Read:   resourceDomainZoneSoaRead,
+		Update: resourceDomainZoneSoaCreateOrUpdate,
+		Delete: resourceDomainZoneSoaDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDomainZoneSoaImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the domain zone",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "Email address of the DNS administrator",
+				Optional:    true,
+				Computed:    true,
+			},
+			"expire": {
+				Type:        schema.TypeInt,
+				Description: "Time in seconds after which secondary servers stop answering when the primary is unreachable",
+				Optional:    true,
+				Computed:    true,
+			},
+			"nx_domain_ttl": {
+				Type:        schema.TypeInt,
+				Description: "Negative caching TTL in seconds",
+				Optional:    true,
+				Computed:    true,
+			},
+			"refresh": {
+				Type:        schema.TypeInt,
+				Description: "Time in seconds between secondary servers refreshes",
+				Optional:    true,
+				Computed:    true,
+			},
+			"retry": {
+				Type:        schema.TypeInt,
+				Description: "Time in seconds before secondary servers retry a failed refresh",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Description: "Default TTL in seconds of the zone records",
+				Optional:    true,
+				Computed:    true,
+			},
+
+			// Computed
+			"serial": {
+				Type:        schema.TypeInt,
+				Description: "Serial number of the zone",
+				Computed:    true,
+			},
+			"server": {
+				Type:        schema.TypeString,
+				Description: "Primary authoritative server of the zone",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDomainZoneSoaImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone", d.Id())
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceDomainZoneSoaCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+	opts := (&DomainZoneSoaUpdateOpts{}).FromResource(d)
+
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/soa",
+		url.PathEscape(zone),
+	)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(zone)
+
+	if err := ovhDomainZoneRefresh(d, meta); err != nil {
+		log.Printf("[WARN] OVH Domain zone refresh after SOA update failed: %s", err)
+	}
+
+	return resourceDomainZoneSoaRead(d, meta)
+}
+
+func resourceDomainZoneSoaRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zone := d.Get("zone").(string)
+
+	soa, err := getDomainZoneSoa(zone, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range soa.ToMap() {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func resourceDomainZoneSoaDelete(d *schema.ResourceData, meta interface{}) error {
+	// the SOA can't be deleted, just forget about it
+	d.SetId("")
+	return nil
+}
+
+func getDomainZoneSoa(zone string, c *ovh.Client) (*DomainZoneSoa, error) {
+	soa := &DomainZoneSoa{}
+	endpoint := fmt.Sprintf(
+		"/domain/zone/%s/soa",
+		url.PathEscape(zone),
+	)
+
+	if err := c.Get(endpoint, soa); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return soa, nil
+}
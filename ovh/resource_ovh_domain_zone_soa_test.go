@@ -0,0 +1,55 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainZoneSoa_basic(t *testing.T) {
+	zone := os.Getenv("OVH_ZONE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDomain(t); testAccCheckDomainZoneExists(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDomainZoneSoaConfig, zone, 300, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_soa.soa", "zone", zone),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_soa.soa", "nx_domain_ttl", "300"),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_soa.soa", "retry", "3600"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_domain_zone_soa.soa", "serial"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccDomainZoneSoaConfig, zone, 86400, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_soa.soa", "nx_domain_ttl", "86400"),
+					resource.TestCheckResourceAttr(
+						"ovh_domain_zone_soa.soa", "retry", "7200"),
+				),
+			},
+			{
+				ResourceName:      "ovh_domain_zone_soa.soa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccDomainZoneSoaConfig = `
+resource "ovh_domain_zone_soa" "soa" {
+  zone          = "%s"
+  nx_domain_ttl = %d
+  retry         = %d
+}
+`
@@ -0,0 +1,256 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpFirewall() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpFirewallCreate,
+		Read:   resourceIpFirewallRead,
+		Update: resourceIpFirewallUpdate,
+		Delete: resourceIpFirewallDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpFirewallImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The IP block",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_on_firewall": {
+				Type:        schema.TypeString,
+				Description: "The IPv4 of the block to filter",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the firewall rules are applied on the IP",
+				Optional:    true,
+				Default:     true,
+			},
+
+			// Computed
+			"state": {
+				Type:        schema.TypeString,
+				Description: "State of the firewall",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIpFirewallImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "|", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not ip|ip_on_firewall formatted")
+	}
+	d.Set("ip", splitId[0])
+	d.Set("ip_on_firewall", splitId[1])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpFirewallCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	opts := &IpFirewallCreateOpts{
+		IpOnFirewall: d.Get("ip_on_firewall").(string),
+	}
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall",
+		url.PathEscape(ip),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", ip, opts.IpOnFirewall))
+
+	// firewalls are created disabled
+	if err := waitForIpFirewallState(ip, opts.IpOnFirewall, "disabled", config.OVHClient); err != nil {
+		return err
+	}
+
+	if d.Get("enabled").(bool) {
+		if err := updateIpFirewall(ip, opts.IpOnFirewall, true, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceIpFirewallRead(d, meta)
+}
+
+func resourceIpFirewallRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s",
+		url.PathEscape(ip),
+		url.PathEscape(ipOnFirewall),
+	)
+
+	firewall := &IpFirewall{}
+	if err := config.OVHClient.Get(endpoint, firewall); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", ip, firewall.IpOnFirewall))
+	d.Set("ip_on_firewall", firewall.IpOnFirewall)
+	d.Set("enabled", firewall.Enabled)
+	d.Set("state", firewall.State)
+
+	return nil
+}
+
+func resourceIpFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+
+	if d.HasChange("enabled") {
+		if err := updateIpFirewall(ip, ipOnFirewall, d.Get("enabled").(bool), config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceIpFirewallRead(d, meta)
+}
+
+func resourceIpFirewallDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s",
+		url.PathEscape(ip),
+		url.PathEscape(ipOnFirewall),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForIpFirewallState(ip, ipOnFirewall, "deleted", config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func updateIpFirewall(ip, ipOnFirewall string, enabled bool, c *ovh.Client) error {
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s",
+		url.PathEscape(ip),
+		url.PathEscape(ipOnFirewall),
+	)
+
+	opts := &IpFirewallUpdateOpts{Enabled: enabled}
+	if err := c.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	target := "disabled"
+	if enabled {
+		target = "enabled"
+	}
+
+	return waitForIpFirewallState(ip, ipOnFirewall, target, c)
+}
+
+// waitForIpFirewallState waits for the firewall of an IP to reach the target
+// state: "enabled" or "disabled" once its state is ok, "deleted" once it is not
+// found. The firewall state is ok before an update is taken into account, so
+// the enabled flag has to be checked as well.
+func waitForIpFirewallState(ip, ipOnFirewall, target string, c *ovh.Client) error {
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s",
+		url.PathEscape(ip),
+		url.PathEscape(ipOnFirewall),
+	)
+
+	pending := []string{}
+	for _, state := range []string{
+		"deleted",
+		"disabled",
+		"enabled",
+		"pendingActivation",
+		"pendingDeactivation",
+		"pendingDelete",
+		"pendingDisable",
+		"pendingEnable",
+	} {
+		if state != target {
+			pending = append(pending, state)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			firewall := &IpFirewall{}
+			if err := c.Get(endpoint, firewall); err != nil {
+				if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+					return ipOnFirewall, "deleted", nil
+				}
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] Firewall of %s on %s is %s, enabled: %t", ipOnFirewall, ip, firewall.State, firewall.Enabled)
+
+			if firewall.State != "ok" {
+				return firewall, firewall.State, nil
+			}
+			if firewall.Enabled {
+				return firewall, "enabled", nil
+			}
+			return firewall, "disabled", nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for firewall of %s on %s to be %s: %s", ipOnFirewall, ip, target, err)
+	}
+
+	return nil
+}
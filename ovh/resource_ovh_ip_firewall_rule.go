@@ -0,0 +1,331 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpFirewallRuleCreate,
+		Read:   resourceIpFirewallRuleRead,
+		Delete: resourceIpFirewallRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpFirewallRuleImportState,
+		},
+		CustomizeDiff: resourceIpFirewallRuleCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The IP block",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_on_firewall": {
+				Type:        schema.TypeString,
+				Description: "The IPv4 of the block the rule applies to",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"sequence": {
+				Type:        schema.TypeInt,
+				Description: "Position of the rule, from 0 to 19. Rules are evaluated in ascending order",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if v.(int) < 0 || v.(int) > 19 {
+						errors = append(errors, fmt.Errorf("%s must be between 0 and 19, got %d", k, v.(int)))
+					}
+					return
+				},
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Description: "Action of the rule: permit or deny",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpFirewallRuleAction(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Description: "Network protocol matched by the rule: ah, esp, gre, icmp, ipv4, tcp or udp",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpFirewallRuleProtocol(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Description: "Source IP block matched by the rule. Any source if not set",
+				Optional:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"source_port": {
+				Type:        schema.TypeInt,
+				Description: "Source port matched by the rule, for tcp and udp",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"destination_port": {
+				Type:        schema.TypeInt,
+				Description: "Destination port matched by the rule, for tcp and udp",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"tcp_option": {
+				Type:        schema.TypeString,
+				Description: "TCP option matched by the rule: established or syn",
+				Optional:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpFirewallRuleTcpOption(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"fragments": {
+				Type:        schema.TypeBool,
+				Description: "Match fragmented TCP packets",
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			// Computed
+			"destination": {
+				Type:        schema.TypeString,
+				Description: "Destination IP of the rule",
+				Computed:    true,
+			},
+			"rule": {
+				Type:        schema.TypeString,
+				Description: "Human readable summary of the rule",
+				Computed:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "State of the rule",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// resourceIpFirewallRuleCustomizeDiff rejects the TCP only options on rules
+// of other protocols, which the API refuses at apply time.
+func resourceIpFirewallRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("protocol") || d.Get("protocol").(string) == "tcp" {
+		return nil
+	}
+	protocol := d.Get("protocol").(string)
+
+	if d.NewValueKnown("tcp_option") && d.Get("tcp_option").(string) != "" {
+		return fmt.Errorf("tcp_option can only be set if protocol is tcp, got %s", protocol)
+	}
+
+	if d.NewValueKnown("fragments") && d.Get("fragments").(bool) {
+		return fmt.Errorf("fragments can only be set if protocol is tcp, got %s", protocol)
+	}
+
+	return nil
+}
+
+func resourceIpFirewallRuleImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "|", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not ip|ip_on_firewall|sequence formatted")
+	}
+	sequence, err := strconv.Atoi(splitId[2])
+	if err != nil {
+		return nil, fmt.Errorf("Import Id sequence %s is not an integer", splitId[2])
+	}
+	d.Set("ip", splitId[0])
+	d.Set("ip_on_firewall", splitId[1])
+	d.Set("sequence", sequence)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+	opts := (&IpFirewallRuleCreateOpts{}).FromResource(d)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s/rule",
+		url.PathEscape(ip),
+		url.PathEscape(ipOnFirewall),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s|%d", ip, ipOnFirewall, opts.Sequence))
+
+	if err := waitForIpFirewallRuleState(ip, ipOnFirewall, opts.Sequence, "ok", config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceIpFirewallRuleRead(d, meta)
+}
+
+func resourceIpFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+	sequence := d.Get("sequence").(int)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s/rule/%d",
+		url.PathEscape(ip),
+		url.PathEscape(ipOnFirewall),
+		sequence,
+	)
+
+	rule := &IpFirewallRule{}
+	if err := config.OVHClient.Get(endpoint, rule); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	log.Printf("[DEBUG] Read firewall rule %s", rule)
+
+	d.SetId(fmt.Sprintf("%s|%s|%d", ip, ipOnFirewall, rule.Sequence))
+	d.Set("sequence", rule.Sequence)
+	d.Set("action", rule.Action)
+	d.Set("protocol", rule.Protocol)
+	d.Set("tcp_option", rule.TcpOption)
+	d.Set("fragments", rule.Fragments)
+	d.Set("destination", rule.Destination)
+	d.Set("rule", rule.Rule)
+	d.Set("state", rule.State)
+
+	// "any" sources are returned as empty values
+	if rule.Source != "" && rule.Source != "any" {
+		d.Set("source", rule.Source)
+	}
+
+	// ports are returned as "eq 80" like strings
+	if port, err := ipFirewallRulePort(rule.SourcePort); err == nil {
+		d.Set("source_port", port)
+	}
+	if port, err := ipFirewallRulePort(rule.DestinationPort); err == nil {
+		d.Set("destination_port", port)
+	}
+
+	return nil
+}
+
+func resourceIpFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipOnFirewall := d.Get("ip_on_firewall").(string)
+	sequence := d.Get("sequence").(int)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s/rule/%d",
+		url.PathEscape(ip),
+		url.PathEscape(ipOnFirewall),
+		sequence,
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForIpFirewallRuleState(ip, ipOnFirewall, sequence, "deleted", config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func ipFirewallRulePort(port string) (int, error) {
+	return strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(port, "eq")))
+}
+
+// waitForIpFirewallRuleState waits for a firewall rule to reach the target
+// state. The "deleted" target is reached once the rule is not found.
+func waitForIpFirewallRuleState(ip, ipOnFirewall string, sequence int, target string, c *ovh.Client) error {
+	endpoint := fmt.Sprintf(
+		"/ip/%s/firewall/%s/rule/%d",
+		url.PathEscape(ip),
+		url.PathEscape(ipOnFirewall),
+		sequence,
+	)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creationPending", "removalPending"},
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			rule := &IpFirewallRule{}
+			if err := c.Get(endpoint, rule); err != nil {
+				if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+					return sequence, "deleted", nil
+				}
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] Firewall rule %d of %s on %s is %s", sequence, ipOnFirewall, ip, rule.State)
+			return rule, rule.State, nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for firewall rule %d of %s on %s to be %s: %s", sequence, ipOnFirewall, ip, target, err)
+	}
+
+	return nil
+}
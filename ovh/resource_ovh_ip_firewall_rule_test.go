@@ -0,0 +1,93 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpFirewallRule_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	ip := os.Getenv("OVH_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpFirewallRuleConfig, block, ip),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall_rule.ssh", "state", "ok"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall_rule.ssh", "destination_port", "22"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_firewall_rule.deny", "protocol", "ipv4"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_firewall_rule.ssh",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIpFirewallRule_tcpOptionWithoutTcp(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	ip := os.Getenv("OVH_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccIpFirewallRuleConfig_udpWithTcpOption, block, ip),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("tcp_option can only be set if protocol is tcp"),
+			},
+		},
+	})
+}
+
+const testAccIpFirewallRuleConfig = `
+resource "ovh_ip_firewall" "firewall" {
+  ip             = "%s"
+  ip_on_firewall = "%s"
+}
+
+resource "ovh_ip_firewall_rule" "ssh" {
+  ip               = ovh_ip_firewall.firewall.ip
+  ip_on_firewall   = ovh_ip_firewall.firewall.ip_on_firewall
+  sequence         = 0
+  action           = "permit"
+  protocol         = "tcp"
+  source           = "192.0.2.0/24"
+  destination_port = 22
+  tcp_option       = "syn"
+}
+
+resource "ovh_ip_firewall_rule" "deny" {
+  ip             = ovh_ip_firewall.firewall.ip
+  ip_on_firewall = ovh_ip_firewall.firewall.ip_on_firewall
+  sequence       = 19
+  action         = "deny"
+  protocol       = "ipv4"
+}
+`
+
+const testAccIpFirewallRuleConfig_udpWithTcpOption = `
+resource "ovh_ip_firewall_rule" "dns" {
+  ip               = "%s"
+  ip_on_firewall   = "%s"
+  sequence         = 1
+  action           = "permit"
+  protocol         = "udp"
+  destination_port = 53
+  tcp_option       = "established"
+}
+`
@@ -0,0 +1,279 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpMitigation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpMitigationCreate,
+		Read:   resourceIpMitigationRead,
+		Update: resourceIpMitigationUpdate,
+		Delete: resourceIpMitigationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpMitigationImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The IP block",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_on_mitigation": {
+				Type:        schema.TypeString,
+				Description: "The IPv4 of the block to mitigate",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"permanent": {
+				Type:        schema.TypeBool,
+				Description: "Whether the mitigation is permanent",
+				Optional:    true,
+				Default:     true,
+			},
+
+			// Computed
+			"auto": {
+				Type:        schema.TypeBool,
+				Description: "Whether the mitigation was triggered automatically by an attack",
+				Computed:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "State of the mitigation",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIpMitigationImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "|", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not ip|ip_on_mitigation formatted")
+	}
+	d.Set("ip", splitId[0])
+	d.Set("ip_on_mitigation", splitId[1])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpMitigationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipOnMitigation := d.Get("ip_on_mitigation").(string)
+
+	// an IP under attack is already on mitigation, in which case
+	// only its permanent flag has to be updated
+	mitigation, err := getIpMitigation(ip, ipOnMitigation, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	if mitigation == nil {
+		opts := &IpMitigationCreateOpts{IpOnMitigation: ipOnMitigation}
+		endpoint := fmt.Sprintf(
+			"/ip/%s/mitigation",
+			url.PathEscape(ip),
+		)
+
+		mitigation = &IpMitigation{}
+		if err := config.OVHClient.Post(endpoint, opts, mitigation); err != nil {
+			return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+
+		if err := waitForIpMitigationState(ip, ipOnMitigation, []string{"auto", "permanent"}, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", ip, ipOnMitigation))
+
+	if mitigation.Permanent != d.Get("permanent").(bool) {
+		if err := updateIpMitigation(ip, ipOnMitigation, d.Get("permanent").(bool), config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceIpMitigationRead(d, meta)
+}
+
+func resourceIpMitigationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipOnMitigation := d.Get("ip_on_mitigation").(string)
+
+	mitigation, err := getIpMitigation(ip, ipOnMitigation, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	if mitigation == nil {
+		log.Printf("[WARN] Mitigation of %s on %s not found, removing it from state", ipOnMitigation, ip)
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", ip, mitigation.IpOnMitigation))
+	d.Set("ip_on_mitigation", mitigation.IpOnMitigation)
+	d.Set("permanent", mitigation.Permanent)
+	d.Set("auto", mitigation.Auto)
+	d.Set("state", mitigation.State)
+
+	return nil
+}
+
+func resourceIpMitigationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipOnMitigation := d.Get("ip_on_mitigation").(string)
+
+	if d.HasChange("permanent") {
+		if err := updateIpMitigation(ip, ipOnMitigation, d.Get("permanent").(bool), config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceIpMitigationRead(d, meta)
+}
+
+func resourceIpMitigationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipOnMitigation := d.Get("ip_on_mitigation").(string)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigation/%s",
+		url.PathEscape(ip),
+		url.PathEscape(ipOnMitigation),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForIpMitigationState(ip, ipOnMitigation, []string{"deleted"}, config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getIpMitigation returns the mitigation of an IP, or nil if the IP
+// is not on mitigation.
+func getIpMitigation(ip, ipOnMitigation string, c *ovh.Client) (*IpMitigation, error) {
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigation/%s",
+		url.PathEscape(ip),
+		url.PathEscape(ipOnMitigation),
+	)
+
+	mitigation := &IpMitigation{}
+	if err := c.Get(endpoint, mitigation); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	return mitigation, nil
+}
+
+func updateIpMitigation(ip, ipOnMitigation string, permanent bool, c *ovh.Client) error {
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigation/%s",
+		url.PathEscape(ip),
+		url.PathEscape(ipOnMitigation),
+	)
+
+	opts := &IpMitigationUpdateOpts{Permanent: permanent}
+	if err := c.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	target := "auto"
+	if permanent {
+		target = "permanent"
+	}
+
+	return waitForIpMitigationState(ip, ipOnMitigation, []string{target}, c)
+}
+
+// waitForIpMitigationState waits for the mitigation of an IP to reach one of
+// the target states: "permanent" or "auto" once its state is ok, "deleted"
+// once it is not found. The mitigation state is ok before an update is taken
+// into account, so the permanent flag has to be checked as well.
+func waitForIpMitigationState(ip, ipOnMitigation string, targets []string, c *ovh.Client) error {
+	pending := []string{}
+	for _, state := range []string{"auto", "creationPending", "deleted", "permanent", "removalPending"} {
+		isTarget := false
+		for _, target := range targets {
+			isTarget = isTarget || state == target
+		}
+		if !isTarget {
+			pending = append(pending, state)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  targets,
+		Refresh: func() (interface{}, string, error) {
+			mitigation, err := getIpMitigation(ip, ipOnMitigation, c)
+			if err != nil {
+				return nil, "", err
+			}
+			if mitigation == nil {
+				return ipOnMitigation, "deleted", nil
+			}
+			log.Printf("[DEBUG] Mitigation of %s on %s is %s, permanent: %t", ipOnMitigation, ip, mitigation.State, mitigation.Permanent)
+
+			if mitigation.State != "ok" {
+				return mitigation, mitigation.State, nil
+			}
+			if mitigation.Permanent {
+				return mitigation, "permanent", nil
+			}
+			return mitigation, "auto", nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for mitigation of %s on %s to be %s: %s", ipOnMitigation, ip, strings.Join(targets, " or "), err)
+	}
+
+	return nil
+}
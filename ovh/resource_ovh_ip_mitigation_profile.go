@@ -0,0 +1,222 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpMitigationProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpMitigationProfileCreate,
+		Read:   resourceIpMitigationProfileRead,
+		Update: resourceIpMitigationProfileUpdate,
+		Delete: resourceIpMitigationProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpMitigationProfileImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The IP block",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_mitigation_profile": {
+				Type:        schema.TypeString,
+				Description: "The IPv4 of the block the profile applies to",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"auto_mitigation_timeout": {
+				Type:        schema.TypeInt,
+				Description: "Delay in minutes before auto mitigation stops after an attack: 0, 15, 1560 or 3120",
+				Required:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpMitigationAutoTimeOut(v.(int))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+
+			// Computed
+			"state": {
+				Type:        schema.TypeString,
+				Description: "State of the profile",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIpMitigationProfileImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "|", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not ip|ip_mitigation_profile formatted")
+	}
+	d.Set("ip", splitId[0])
+	d.Set("ip_mitigation_profile", splitId[1])
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpMitigationProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	opts := &IpMitigationProfileCreateOpts{
+		IpMitigationProfile:   d.Get("ip_mitigation_profile").(string),
+		AutoMitigationTimeOut: d.Get("auto_mitigation_timeout").(int),
+	}
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigationProfiles",
+		url.PathEscape(ip),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", ip, opts.IpMitigationProfile))
+
+	if err := waitForIpMitigationProfileState(ip, opts.IpMitigationProfile, "ok", config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceIpMitigationProfileRead(d, meta)
+}
+
+func resourceIpMitigationProfileRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipMitigationProfile := d.Get("ip_mitigation_profile").(string)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigationProfiles/%s",
+		url.PathEscape(ip),
+		url.PathEscape(ipMitigationProfile),
+	)
+
+	profile := &IpMitigationProfile{}
+	if err := config.OVHClient.Get(endpoint, profile); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", ip, profile.IpMitigationProfile))
+	d.Set("ip_mitigation_profile", profile.IpMitigationProfile)
+	d.Set("auto_mitigation_timeout", profile.AutoMitigationTimeOut)
+	d.Set("state", profile.State)
+
+	return nil
+}
+
+func resourceIpMitigationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipMitigationProfile := d.Get("ip_mitigation_profile").(string)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigationProfiles/%s",
+		url.PathEscape(ip),
+		url.PathEscape(ipMitigationProfile),
+	)
+
+	opts := &IpMitigationProfileUpdateOpts{
+		AutoMitigationTimeOut: d.Get("auto_mitigation_timeout").(int),
+	}
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForIpMitigationProfileState(ip, ipMitigationProfile, "ok", config.OVHClient); err != nil {
+		return err
+	}
+
+	return resourceIpMitigationProfileRead(d, meta)
+}
+
+func resourceIpMitigationProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipMitigationProfile := d.Get("ip_mitigation_profile").(string)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigationProfiles/%s",
+		url.PathEscape(ip),
+		url.PathEscape(ipMitigationProfile),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForIpMitigationProfileState(ip, ipMitigationProfile, "deleted", config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// waitForIpMitigationProfileState waits for a mitigation profile to reach the
+// target state. The "deleted" target is reached once the profile is not found.
+func waitForIpMitigationProfileState(ip, ipMitigationProfile, target string, c *ovh.Client) error {
+	endpoint := fmt.Sprintf(
+		"/ip/%s/mitigationProfiles/%s",
+		url.PathEscape(ip),
+		url.PathEscape(ipMitigationProfile),
+	)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"ok", "tasksPending"},
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			profile := &IpMitigationProfile{}
+			if err := c.Get(endpoint, profile); err != nil {
+				if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+					return ipMitigationProfile, "deleted", nil
+				}
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] Mitigation profile of %s on %s is %s", ipMitigationProfile, ip, profile.State)
+			return profile, profile.State, nil
+		},
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for mitigation profile of %s on %s to be %s: %s", ipMitigationProfile, ip, target, err)
+	}
+
+	return nil
+}
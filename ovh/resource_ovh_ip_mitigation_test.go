@@ -0,0 +1,68 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpMitigation_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	ip := os.Getenv("OVH_IP")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpMitigationConfig, block, ip),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_mitigation.mitigation", "permanent", "true"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_mitigation.mitigation", "state", "ok"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_ip_mitigation.mitigation", "permanent", "true"),
+					resource.TestCheckResourceAttrSet(
+						"data.ovh_ip_mitigation.mitigation", "stats.#"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpMitigationConfig_notPermanent, block, ip),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_mitigation.mitigation", "permanent", "false"),
+					resource.TestCheckResourceAttr(
+						"ovh_ip_mitigation.mitigation", "state", "ok"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_mitigation.mitigation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIpMitigationConfig = `
+resource "ovh_ip_mitigation" "mitigation" {
+  ip               = "%s"
+  ip_on_mitigation = "%s"
+}
+
+data "ovh_ip_mitigation" "mitigation" {
+  ip               = ovh_ip_mitigation.mitigation.ip
+  ip_on_mitigation = ovh_ip_mitigation.mitigation.ip_on_mitigation
+}
+`
+
+const testAccIpMitigationConfig_notPermanent = `
+resource "ovh_ip_mitigation" "mitigation" {
+  ip               = "%s"
+  ip_on_mitigation = "%s"
+  permanent        = false
+}
+`
@@ -0,0 +1,143 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceIpMove() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpMoveCreateOrUpdate,
+		Read:   resourceIpMoveRead,
+		Update: resourceIpMoveCreateOrUpdate,
+		Delete: resourceIpMoveDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpMoveImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The IP block to move",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"routed_to_service_name": {
+				Type:        schema.TypeString,
+				Description: "Service the IP block is routed to: a dedicated server, a VPS or a cloud project",
+				Required:    true,
+			},
+			"nexthop": {
+				Type:        schema.TypeString,
+				Description: "Nexthop of the IP block on the destination service, when several are available",
+				Optional:    true,
+			},
+
+			// Computed
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Type of the IP block",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIpMoveImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("ip", d.Id())
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpMoveCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+	serviceName := d.Get("routed_to_service_name").(string)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	ip, err := getIp(block, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	if ip.RoutedTo != nil && ip.RoutedTo.ServiceName == serviceName && !d.HasChange("nexthop") {
+		log.Printf("[DEBUG] IP block %s is already routed to %s", block, serviceName)
+	} else {
+		opts := &IpMoveOpts{
+			To:      serviceName,
+			Nexthop: helpers.GetNilStringPointerFromData(d, "nexthop"),
+		}
+
+		endpoint := fmt.Sprintf(
+			"/ip/%s/move",
+			url.PathEscape(block),
+		)
+
+		task := &IpTask{}
+		if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+			return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+
+		if err := waitForIpTask(block, task, timeout, config.OVHClient); err != nil {
+			return fmt.Errorf("Error waiting for IP block %s to be moved to %s: %s", block, serviceName, err)
+		}
+	}
+
+	d.SetId(block)
+
+	return resourceIpMoveRead(d, meta)
+}
+
+func resourceIpMoveRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s",
+		url.PathEscape(block),
+	)
+
+	ip := &Ip{}
+	if err := config.OVHClient.Get(endpoint, ip); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("type", ip.Type)
+	if ip.RoutedTo != nil {
+		d.Set("routed_to_service_name", ip.RoutedTo.ServiceName)
+	} else {
+		d.Set("routed_to_service_name", "")
+	}
+
+	return nil
+}
+
+func resourceIpMoveDelete(d *schema.ResourceData, meta interface{}) error {
+	// the IP block is left routed to its current service,
+	// just forget about it
+	d.SetId("")
+	return nil
+}
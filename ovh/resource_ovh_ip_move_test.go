@@ -0,0 +1,50 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpMove_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_MOVE_BLOCK")
+	service1 := os.Getenv("OVH_IP_MOVE_SERVICE_1")
+	service2 := os.Getenv("OVH_IP_MOVE_SERVICE_2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpMove(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpMoveConfig, block, service1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_move.move", "routed_to_service_name", service1),
+					resource.TestCheckResourceAttrSet(
+						"ovh_ip_move.move", "type"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpMoveConfig, block, service2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_move.move", "routed_to_service_name", service2),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_move.move",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIpMoveConfig = `
+resource "ovh_ip_move" "move" {
+  ip                     = "%s"
+  routed_to_service_name = "%s"
+}
+`
@@ -23,11 +23,15 @@ func resourceOvhIpReverse() *schema.Resource {
 		Read:   resourceOvhIpReverseRead,
 		Update: resourceOvhIpReverseUpdate,
 		Delete: resourceOvhIpReverseDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOvhIpReverseImportState,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ip": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: helpers.DiffSuppressIpBlock,
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					err := helpers.ValidateIpBlock(v.(string))
 					if err != nil {
@@ -37,8 +41,10 @@ func resourceOvhIpReverse() *schema.Resource {
 				},
 			},
 			"ipreverse": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: helpers.DiffSuppressIp,
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					err := helpers.ValidateIp(v.(string))
 					if err != nil {
@@ -48,13 +54,31 @@ func resourceOvhIpReverse() *schema.Resource {
 				},
 			},
 			"reverse": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: helpers.DiffSuppressFqdn,
 			},
 		},
 	}
 }
 
+func resourceOvhIpReverseImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "|", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not block|address formatted")
+	}
+	ip := splitId[0]
+	ipReverse := helpers.NormalizeIp(splitId[1])
+	d.SetId(fmt.Sprintf("%s_%s", ip, ipReverse))
+	d.Set("ip", ip)
+	d.Set("ipreverse", ipReverse)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
 func resourceOvhIpReverseCreate(d *schema.ResourceData, meta interface{}) error {
 	provider := meta.(*Config)
 
@@ -114,7 +138,9 @@ func resourceOvhIpReverseRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("ipreverse", reverse.IpReverse)
-	d.Set("reverse", reverse.Reverse)
+	// the API returns the reverse with its trailing dot,
+	// whether or not it was given
+	d.Set("reverse", helpers.NormalizeFqdn(reverse.Reverse))
 
 	return nil
 }
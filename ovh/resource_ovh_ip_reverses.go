@@ -0,0 +1,281 @@
+package ovh
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers/hashcode"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpReverses() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpReversesCreate,
+		Read:   resourceIpReversesRead,
+		Update: resourceIpReversesUpdate,
+		Delete: resourceIpReversesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpReversesImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:             schema.TypeString,
+				Description:      "The IP block",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: helpers.DiffSuppressIpBlock,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"reverses": {
+				Type:        schema.TypeSet,
+				Description: "All the reverses of the IP block",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipreverse": {
+							Type:        schema.TypeString,
+							Description: "The IP to set the reverse of",
+							Required:    true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := helpers.ValidateIp(v.(string))
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"reverse": {
+							Type:        schema.TypeString,
+							Description: "The value of the reverse",
+							Required:    true,
+						},
+					},
+				},
+				Set: resourceIpReversesHash,
+			},
+		},
+	}
+}
+
+// resourceIpReversesHash hashes reverses on the canonical spelling of their IP
+// and on their value without trailing dot, as returned by the API.
+func resourceIpReversesHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", helpers.NormalizeIp(m["ipreverse"].(string))))
+	buf.WriteString(fmt.Sprintf("%s-", strings.TrimSuffix(m["reverse"].(string), ".")))
+	return hashcode.String(buf.String())
+}
+
+func resourceIpReversesImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("ip", d.Id())
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpReversesCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+
+	// the resource manages all the reverses of the block,
+	// existing ones are replaced by the configured ones
+	existing, err := getIpReverses(ip, config.OVHClient)
+	if err != nil {
+		return fmt.Errorf("Error listing reverses of %s:\n\t %q", ip, err)
+	}
+
+	if err := updateIpReverses(ip, existing, ipReversesFromSet(d.Get("reverses").(*schema.Set)), config.OVHClient); err != nil {
+		return err
+	}
+
+	d.SetId(ip)
+
+	return resourceIpReversesRead(d, meta)
+}
+
+func resourceIpReversesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+
+	reverses, err := getIpReverses(ip, config.OVHClient)
+	if err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			log.Printf("[WARN] IP block %s not found, removing its reverses from state", ip)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// keep the spellings known in state when the API returns
+	// an equivalent value, to avoid spurious diffs
+	known := map[string]IpReverse{}
+	for _, r := range ipReversesFromSet(d.Get("reverses").(*schema.Set)) {
+		known[helpers.NormalizeIp(r.IpReverse)] = r
+	}
+
+	mapped := make([]interface{}, len(reverses))
+	for i, r := range reverses {
+		if k, ok := known[helpers.NormalizeIp(r.IpReverse)]; ok {
+			r.IpReverse = k.IpReverse
+			if strings.TrimSuffix(k.Reverse, ".") == strings.TrimSuffix(r.Reverse, ".") {
+				r.Reverse = k.Reverse
+			}
+		}
+		mapped[i] = r.ToMap()
+	}
+
+	d.Set("reverses", mapped)
+
+	return nil
+}
+
+func resourceIpReversesUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+
+	if d.HasChange("reverses") {
+		o, n := d.GetChange("reverses")
+		oldReverses := ipReversesFromSet(o.(*schema.Set))
+		newReverses := ipReversesFromSet(n.(*schema.Set))
+
+		if err := updateIpReverses(ip, oldReverses, newReverses, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceIpReversesRead(d, meta)
+}
+
+func resourceIpReversesDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+
+	for _, r := range ipReversesFromSet(d.Get("reverses").(*schema.Set)) {
+		if err := deleteIpReverse(ip, r.IpReverse, config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func ipReversesFromSet(set *schema.Set) []IpReverse {
+	reverses := []IpReverse{}
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		reverses = append(reverses, IpReverse{
+			IpReverse: m["ipreverse"].(string),
+			Reverse:   m["reverse"].(string),
+		})
+	}
+	return reverses
+}
+
+// updateIpReverses deletes the old reverses missing from the new ones
+// and sets the new reverses which are missing or differ from the old ones.
+func updateIpReverses(ip string, oldReverses, newReverses []IpReverse, c *ovh.Client) error {
+	oldByIp := map[string]IpReverse{}
+	for _, r := range oldReverses {
+		oldByIp[helpers.NormalizeIp(r.IpReverse)] = r
+	}
+
+	newByIp := map[string]IpReverse{}
+	for _, r := range newReverses {
+		newByIp[helpers.NormalizeIp(r.IpReverse)] = r
+	}
+
+	for k, r := range oldByIp {
+		if _, ok := newByIp[k]; !ok {
+			if err := deleteIpReverse(ip, r.IpReverse, c); err != nil {
+				return err
+			}
+		}
+	}
+
+	for k, r := range newByIp {
+		if o, ok := oldByIp[k]; ok && strings.TrimSuffix(o.Reverse, ".") == strings.TrimSuffix(r.Reverse, ".") {
+			continue
+		}
+
+		endpoint := fmt.Sprintf(
+			"/ip/%s/reverse",
+			url.PathEscape(ip),
+		)
+
+		opts := &IpReverse{IpReverse: k, Reverse: r.Reverse}
+		if err := c.Post(endpoint, opts, nil); err != nil {
+			return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+		}
+		log.Printf("[DEBUG] Set reverse of %s to %s", k, r.Reverse)
+	}
+
+	return nil
+}
+
+func deleteIpReverse(ip, ipReverse string, c *ovh.Client) error {
+	endpoint := fmt.Sprintf(
+		"/ip/%s/reverse/%s",
+		url.PathEscape(ip),
+		url.PathEscape(helpers.NormalizeIp(ipReverse)),
+	)
+
+	if err := c.Delete(endpoint, nil); err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			return nil
+		}
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	log.Printf("[DEBUG] Deleted reverse of %s", ipReverse)
+	return nil
+}
+
+// getIpReverses returns all the reverses of an IP block, sorted by IP.
+// Errors listing the reverses are returned as is, so that callers can check
+// whether the IP block exists.
+func getIpReverses(ip string, c *ovh.Client) ([]IpReverse, error) {
+	endpoint := fmt.Sprintf(
+		"/ip/%s/reverse",
+		url.PathEscape(ip),
+	)
+
+	ips := []string{}
+	if err := c.Get(endpoint, &ips); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(ips)
+
+	reverses := make([]IpReverse, len(ips))
+	for i, ipReverse := range ips {
+		endpoint := fmt.Sprintf(
+			"/ip/%s/reverse/%s",
+			url.PathEscape(ip),
+			url.PathEscape(ipReverse),
+		)
+
+		if err := c.Get(endpoint, &reverses[i]); err != nil {
+			return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+	}
+
+	return reverses, nil
+}
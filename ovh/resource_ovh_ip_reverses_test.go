@@ -0,0 +1,53 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpReverses_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	ip := os.Getenv("OVH_IP")
+	reverse := os.Getenv("OVH_IP_REVERSE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpReversesConfig, block, ip, reverse),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_reverses.reverses", "reverses.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_ip_reverses.reverses", "reverses.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.ovh_ip_reverses.reverses", "reverses.0.ipreverse", ip),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_reverses.reverses",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIpReversesConfig = `
+resource "ovh_ip_reverses" "reverses" {
+  ip = "%s"
+
+  reverses {
+    ipreverse = "%s"
+    reverse   = "%s"
+  }
+}
+
+data "ovh_ip_reverses" "reverses" {
+  ip = ovh_ip_reverses.reverses.ip
+}
+`
@@ -0,0 +1,194 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceIpService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpServiceCreate,
+		Read:   resourceIpServiceRead,
+		Update: resourceIpServiceUpdate,
+		Delete: resourceIpServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpServiceImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The IP block to adopt",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the IP block",
+				Optional:    true,
+				Computed:    true,
+			},
+			"terminate_on_destroy": {
+				Type:        schema.TypeBool,
+				Description: "Terminate the IP block when the resource is destroyed",
+				Optional:    true,
+				Default:     false,
+			},
+
+			// Computed
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Type of the IP block",
+				Computed:    true,
+			},
+			"routed_to_service_name": {
+				Type:        schema.TypeString,
+				Description: "Service the IP block is routed to",
+				Computed:    true,
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Description: "Country of the IP block",
+				Computed:    true,
+			},
+			"can_be_terminated": {
+				Type:        schema.TypeBool,
+				Description: "Whether the IP block can be terminated",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIpServiceImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("ip", d.Id())
+	d.Set("terminate_on_destroy", false)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	block := d.Get("ip").(string)
+
+	// the IP block is not ordered, only adopted
+	ip, err := getIp(block, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(ip.Ip)
+
+	if _, ok := d.GetOk("description"); ok {
+		if err := updateIpDescription(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceIpServiceRead(d, meta)
+}
+
+func resourceIpServiceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s",
+		url.PathEscape(d.Id()),
+	)
+
+	ip := &Ip{}
+	if err := config.OVHClient.Get(endpoint, ip); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("ip", ip.Ip)
+	d.Set("description", ip.Description)
+	d.Set("type", ip.Type)
+	d.Set("country", ip.Country)
+	d.Set("can_be_terminated", ip.CanBeTerminated)
+	if ip.RoutedTo != nil {
+		d.Set("routed_to_service_name", ip.RoutedTo.ServiceName)
+	} else {
+		d.Set("routed_to_service_name", "")
+	}
+
+	return nil
+}
+
+func resourceIpServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("description") {
+		if err := updateIpDescription(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceIpServiceRead(d, meta)
+}
+
+func resourceIpServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if !d.Get("terminate_on_destroy").(bool) {
+		// the IP block is kept, just forget about it
+		d.SetId("")
+		return nil
+	}
+
+	if !d.Get("can_be_terminated").(bool) {
+		return fmt.Errorf("IP block %s can't be terminated", d.Id())
+	}
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s/terminate",
+		url.PathEscape(d.Id()),
+	)
+
+	task := &IpTask{}
+	if err := config.OVHClient.Post(endpoint, nil, task); err != nil {
+		return fmt.Errorf("Error calling POST %s:\n\t %q", endpoint, err)
+	}
+
+	if err := waitForIpTask(d.Id(), task, d.Timeout(schema.TimeoutDelete), config.OVHClient); err != nil {
+		return fmt.Errorf("Error waiting for IP block %s termination: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] IP block %s terminated", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func updateIpDescription(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	endpoint := fmt.Sprintf(
+		"/ip/%s",
+		url.PathEscape(d.Id()),
+	)
+
+	description := d.Get("description").(string)
+	opts := &IpUpdateOpts{Description: &description}
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	return nil
+}
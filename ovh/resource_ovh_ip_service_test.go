@@ -0,0 +1,50 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpService_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	description := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIp(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpServiceConfig, block, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_service.block", "description", description),
+					resource.TestCheckResourceAttrSet(
+						"ovh_ip_service.block", "type"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpServiceConfig, block, description+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_service.block", "description", description+"-updated"),
+				),
+			},
+			{
+				ResourceName:      "ovh_ip_service.block",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccIpServiceConfig = `
+resource "ovh_ip_service" "block" {
+  ip          = "%s"
+  description = "%s"
+}
+`
@@ -0,0 +1,131 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceIpUnblock() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpUnblockCreate,
+		Read:   resourceIpUnblockRead,
+		Delete: resourceIpUnblockDelete,
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The IP block",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"ip_blocked": {
+				Type:        schema.TypeString,
+				Description: "The blocked IPv4 of the block",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpV4(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Reason the IP is blocked for: spam or antihack",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpUnblockType(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"keepers": {
+				Type:        schema.TypeList,
+				Description: "List of values tracked to trigger a new unblock request",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			// Computed
+			"state": {
+				Type:        schema.TypeString,
+				Description: "State of the IP after the unblock request",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIpUnblockCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ip := d.Get("ip").(string)
+	ipBlocked := d.Get("ip_blocked").(string)
+	kind := d.Get("type").(string)
+
+	var state string
+	switch kind {
+	case "spam":
+		s := &IpSpam{}
+		if err := getIpBlockedIp(ip, kind, ipBlocked, s, config.OVHClient); err != nil {
+			return err
+		}
+		state = s.State
+	case "antihack":
+		a := &IpAntihack{}
+		if err := getIpBlockedIp(ip, kind, ipBlocked, a, config.OVHClient); err != nil {
+			return err
+		}
+		state = a.State
+	}
+
+	if state == "blocked" || state == "blockedForSpam" {
+		endpoint := fmt.Sprintf(
+			"/ip/%s/%s/%s/unblock",
+			url.PathEscape(ip),
+			kind,
+			url.PathEscape(ipBlocked),
+		)
+
+		if err := config.OVHClient.Post(endpoint, nil, nil); err != nil {
+			return fmt.Errorf("Error calling POST %s:\n\t %q", endpoint, err)
+		}
+
+		state = "unblocking"
+	} else {
+		log.Printf("[DEBUG] IP %s of %s is %s, nothing to unblock", ipBlocked, ip, state)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s|%s", ip, ipBlocked, kind))
+	d.Set("state", state)
+
+	return nil
+}
+
+func resourceIpUnblockRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceIpUnblockDelete(d *schema.ResourceData, meta interface{}) error {
+	// an unblock request can't be undone, just forget about it
+	d.SetId("")
+	return nil
+}
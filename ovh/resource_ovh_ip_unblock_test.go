@@ -0,0 +1,37 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpUnblock_basic(t *testing.T) {
+	block := os.Getenv("OVH_IP_BLOCK")
+	ip := os.Getenv("OVH_IP_UNBLOCK")
+	kind := os.Getenv("OVH_IP_UNBLOCK_TYPE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpUnblock(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpUnblockConfig, block, ip, kind),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_ip_unblock.unblock", "state", "unblocking"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIpUnblockConfig = `
+resource "ovh_ip_unblock" "unblock" {
+  ip         = "%s"
+  ip_blocked = "%s"
+  type       = "%s"
+}
+`
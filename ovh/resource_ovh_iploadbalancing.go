@@ -0,0 +1,357 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpLoadbalancing() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpLoadbalancingCreate,
+		Read:   resourceIpLoadbalancingRead,
+		Update: resourceIpLoadbalancingUpdate,
+		Delete: resourceIpLoadbalancingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpLoadbalancingImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "The internal name of your IP load balancing",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Human readable name of your IP load balancing",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ssl_configuration": {
+				Type:        schema.TypeString,
+				Description: "Ciphers policy of the HTTPS frontends: intermediate or modern",
+				Optional:    true,
+				Computed:    true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{"intermediate", "modern"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"zone": {
+				Type:        schema.TypeSet,
+				Description: "Zones of the IP load balancing. Removed zones are terminated",
+				Optional:    true,
+				Computed:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+
+			// Computed
+			"ipv6": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv4": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_loadbalancing": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vrack_eligibility": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"vrack_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metrics_token": {
+				Type:      schema.TypeString,
+				Sensitive: true,
+				Computed:  true,
+			},
+			"orderable_zone": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Set:      orderableZoneHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"plan_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIpLoadbalancingImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("service_name", d.Id())
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpLoadbalancingCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	// the service is ordered out of band, the resource adopts it
+	if _, err := getIpLoadbalancing(serviceName, config.OVHClient); err != nil {
+		return fmt.Errorf("Error calling GET /ipLoadbalancing/%s:\n\t %q", serviceName, err)
+	}
+
+	d.SetId(serviceName)
+
+	if err := updateIpLoadbalancingSettings(d, meta); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("zone"); ok {
+		if err := updateIpLoadbalancingZones(serviceName, v.(*schema.Set), config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceIpLoadbalancingRead(d, meta)
+}
+
+func resourceIpLoadbalancingRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	iplb, err := getIpLoadbalancing(serviceName, config.OVHClient)
+	if err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			log.Printf("[WARN] IP load balancing %s not found, removing it from state", serviceName)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error calling GET /ipLoadbalancing/%s:\n\t %q", serviceName, err)
+	}
+
+	if err := dataSourceIpLoadbalancingAttributes(d, iplb); err != nil {
+		return err
+	}
+
+	// zones being terminated are still listed on the service
+	zones, err := getIpLoadbalancingZones(serviceName, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	activeZones := []string{}
+	for _, zone := range zones {
+		if !ipLoadbalancingZoneTerminated(zone) {
+			activeZones = append(activeZones, zone.Name)
+		}
+	}
+	d.Set("zone", activeZones)
+
+	return nil
+}
+
+func resourceIpLoadbalancingUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	if d.HasChanges("display_name", "ssl_configuration") {
+		if err := updateIpLoadbalancingSettings(d, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("zone") {
+		if err := updateIpLoadbalancingZones(serviceName, d.Get("zone").(*schema.Set), config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceIpLoadbalancingRead(d, meta)
+}
+
+func resourceIpLoadbalancingDelete(d *schema.ResourceData, meta interface{}) error {
+	// the service itself is not terminated, just forget about it
+	d.SetId("")
+	return nil
+}
+
+func getIpLoadbalancing(serviceName string, c *ovh.Client) (*IpLoadbalancing, error) {
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s",
+		url.PathEscape(serviceName),
+	)
+
+	iplb := &IpLoadbalancing{}
+	if err := c.Get(endpoint, iplb); err != nil {
+		return nil, err
+	}
+
+	return iplb, nil
+}
+
+func updateIpLoadbalancingSettings(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	opts := &IpLoadbalancingUpdateOpts{}
+	if v, ok := d.GetOk("display_name"); ok {
+		opts.DisplayName = helpers.GetNilStringPointer(v)
+	}
+	if v, ok := d.GetOk("ssl_configuration"); ok {
+		opts.SslConfiguration = helpers.GetNilStringPointer(v)
+	}
+
+	if opts.DisplayName == nil && opts.SslConfiguration == nil {
+		return nil
+	}
+
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	return nil
+}
+
+// updateIpLoadbalancingZones terminates the zones of the service missing from
+// the wanted ones, and cancels the termination of the wanted zones being
+// terminated. Zones can't be ordered through the API, the wanted zones
+// which are not part of the service yet are reported as an error.
+func updateIpLoadbalancingZones(serviceName string, wanted *schema.Set, c *ovh.Client) error {
+	zones, err := getIpLoadbalancingZones(serviceName, c)
+	if err != nil {
+		return err
+	}
+
+	byName := map[string]*IpLoadbalancingZone{}
+	for _, zone := range zones {
+		byName[zone.Name] = zone
+	}
+
+	// check every wanted zone exists before changing any of them, not to
+	// apply half of the configuration
+	missing := []string{}
+	for _, v := range wanted.List() {
+		if _, ok := byName[v.(string)]; !ok {
+			missing = append(missing, v.(string))
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf(
+			"Zones %s are not part of IP load balancing %s, they have to be ordered first (see orderable_zone)",
+			strings.Join(missing, ", "),
+			serviceName,
+		)
+	}
+
+	for _, v := range wanted.List() {
+		name := v.(string)
+		if ipLoadbalancingZoneTerminated(byName[name]) {
+			if err := postIpLoadbalancingZone(serviceName, name, "cancelTermination", c); err != nil {
+				return err
+			}
+			log.Printf("[DEBUG] Cancelled termination of zone %s of IPLB %s", name, serviceName)
+		}
+	}
+
+	for _, zone := range zones {
+		if wanted.Contains(zone.Name) || ipLoadbalancingZoneTerminated(zone) {
+			continue
+		}
+
+		if err := postIpLoadbalancingZone(serviceName, zone.Name, "terminate", c); err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Terminated zone %s of IPLB %s", zone.Name, serviceName)
+	}
+
+	return nil
+}
+
+func postIpLoadbalancingZone(serviceName, zone, action string, c *ovh.Client) error {
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/zone/%s/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(zone),
+		action,
+	)
+
+	if err := c.Post(endpoint, nil, nil); err != nil {
+		return fmt.Errorf("Error calling POST %s:\n\t %q", endpoint, err)
+	}
+
+	return nil
+}
+
+// getIpLoadbalancingZones returns the zones of the service, sorted by name.
+func getIpLoadbalancingZones(serviceName string, c *ovh.Client) ([]*IpLoadbalancingZone, error) {
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/zone",
+		url.PathEscape(serviceName),
+	)
+
+	names := []string{}
+	if err := c.Get(endpoint, &names); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	sort.Strings(names)
+
+	zones := make([]*IpLoadbalancingZone, len(names))
+	for i, name := range names {
+		endpoint := fmt.Sprintf(
+			"/ipLoadbalancing/%s/zone/%s",
+			url.PathEscape(serviceName),
+			url.PathEscape(name),
+		)
+
+		zones[i] = &IpLoadbalancingZone{}
+		if err := c.Get(endpoint, zones[i]); err != nil {
+			return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+		}
+	}
+
+	return zones, nil
+}
+
+func ipLoadbalancingZoneTerminated(zone *IpLoadbalancingZone) bool {
+	return zone.State == "releasing" || zone.State == "released"
+}
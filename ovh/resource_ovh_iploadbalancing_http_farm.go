@@ -10,10 +10,10 @@ import (
 
 func resourceIpLoadbalancingHttpFarm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpLoadbalancingHttpFarmCreate,
-		Read:   resourceIpLoadbalancingHttpFarmRead,
-		Update: resourceIpLoadbalancingHttpFarmUpdate,
-		Delete: resourceIpLoadbalancingHttpFarmDelete,
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingHttpFarmCreate),
+		Read:          resourceIpLoadbalancingHttpFarmRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingHttpFarmUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingHttpFarmDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingHttpFarmImportState,
 		},
@@ -229,7 +229,6 @@ func resourceIpLoadbalancingHttpFarmUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	return resourceIpLoadbalancingHttpFarmRead(d, meta)
-
 }
 
 func resourceIpLoadbalancingHttpFarmDelete(d *schema.ResourceData, meta interface{}) error {
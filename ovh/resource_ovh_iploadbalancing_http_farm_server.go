@@ -12,10 +12,10 @@ import (
 
 func resourceIpLoadbalancingHttpFarmServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpLoadbalancingHttpFarmServerCreate,
-		Read:   resourceIpLoadbalancingHttpFarmServerRead,
-		Update: resourceIpLoadbalancingHttpFarmServerUpdate,
-		Delete: resourceIpLoadbalancingHttpFarmServerDelete,
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingHttpFarmServerCreate),
+		Read:          resourceIpLoadbalancingHttpFarmServerRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingHttpFarmServerUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingHttpFarmServerDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingHttpFarmServerImportState,
 		},
@@ -206,6 +206,7 @@ func resourceIpLoadbalancingHttpFarmServerUpdate(d *schema.ResourceData, meta in
 	if err != nil {
 		return fmt.Errorf("calling PUT %s with %d:\n\t %s", endpoint, farmid, err.Error())
 	}
+
 	return resourceIpLoadbalancingHttpFarmServerRead(d, meta)
 }
 
@@ -0,0 +1,180 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	testAccIpLoadbalancingHttpFarmServersConfig_templ = `
+data ovh_iploadbalancing iplb {
+  service_name = "%s"
+}
+
+resource ovh_iploadbalancing_http_farm testacc {
+  service_name = data.ovh_iploadbalancing.iplb.id
+  display_name = "%s"
+  port         = 8080
+  zone         = "all"
+}
+`
+	testAccIpLoadbalancingHttpFarmServersConfig_step0 = `
+%s
+
+resource ovh_iploadbalancing_http_farm_servers testacc {
+  service_name = data.ovh_iploadbalancing.iplb.id
+  farm_id      = ovh_iploadbalancing_http_farm.testacc.id
+
+  server {
+    address      = "10.0.0.11"
+    display_name = "testBackendA"
+    weight       = 3
+  }
+
+  server {
+    address = "10.0.0.12"
+    port    = 80
+    backup  = true
+  }
+}
+`
+	testAccIpLoadbalancingHttpFarmServersConfig_step1 = `
+%s
+
+resource ovh_iploadbalancing_http_farm_servers testacc {
+  service_name = data.ovh_iploadbalancing.iplb.id
+  farm_id      = ovh_iploadbalancing_http_farm.testacc.id
+
+  server {
+    address      = "10.0.0.11"
+    display_name = "testBackendA"
+    weight       = 2
+    probe        = true
+  }
+
+  server {
+    address = "10.0.0.13"
+    port    = 80
+    ssl     = true
+    status  = "inactive"
+  }
+}
+`
+)
+
+func TestAccIpLoadbalancingHttpFarmServers_basic(t *testing.T) {
+	prefix := fmt.Sprintf(
+		testAccIpLoadbalancingHttpFarmServersConfig_templ,
+		os.Getenv("OVH_IPLB_SERVICE"),
+		acctest.RandomWithPrefix(test_prefix),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckIpLoadbalancing(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpLoadbalancingFarmServersDestroy("ovh_iploadbalancing_http_farm_servers", "http"),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingHttpFarmServersConfig_step0, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_http_farm_servers.testacc", "server.#", "2"),
+					testAccCheckIpLoadbalancingFarmServersCount("ovh_iploadbalancing_http_farm_servers.testacc", "http", 2),
+				),
+			},
+			{
+				// a server added out of band is deleted
+				PreConfig: func() {
+					testAccIpLoadbalancingFarmServersAddServer(t, "ovh_iploadbalancing_http_farm.testacc", "http")
+				},
+				Config: fmt.Sprintf(testAccIpLoadbalancingHttpFarmServersConfig_step0, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_http_farm_servers.testacc", "server.#", "2"),
+					testAccCheckIpLoadbalancingFarmServersCount("ovh_iploadbalancing_http_farm_servers.testacc", "http", 2),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingHttpFarmServersConfig_step1, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_http_farm_servers.testacc", "server.#", "2"),
+					testAccCheckIpLoadbalancingFarmServersCount("ovh_iploadbalancing_http_farm_servers.testacc", "http", 2),
+				),
+			},
+		},
+	})
+}
+
+// testAccFarmServersLastFarmId keeps the farm of the last test step,
+// so that servers can be added to it out of band
+var testAccFarmServersLastFarmId = map[string]int{}
+
+func testAccCheckIpLoadbalancingFarmServersCount(resourceName, protocol string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found", resourceName)
+		}
+
+		farmId, err := strconv.Atoi(rs.Primary.Attributes["farm_id"])
+		if err != nil {
+			return err
+		}
+		testAccFarmServersLastFarmId[protocol] = farmId
+
+		config := testAccProvider.Meta().(*Config)
+		servers, err := getIpLoadbalancingFarmServers(protocol, rs.Primary.Attributes["service_name"], farmId, config.OVHClient)
+		if err != nil {
+			return err
+		}
+
+		if len(servers) != count {
+			return fmt.Errorf("%s farm %d has %d servers, %d expected", protocol, farmId, len(servers), count)
+		}
+		return nil
+	}
+}
+
+func testAccIpLoadbalancingFarmServersAddServer(t *testing.T, farmName, protocol string) {
+	config := testAccProvider.Meta().(*Config)
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/%s/farm/%d/server",
+		os.Getenv("OVH_IPLB_SERVICE"),
+		protocol,
+		testAccFarmServersLastFarmId[protocol],
+	)
+
+	opts := &IpLoadbalancingFarmServerCreateOpts{
+		Address: "10.0.0.99",
+		Status:  "active",
+	}
+	if err := config.OVHClient.Post(endpoint, opts, nil); err != nil {
+		t.Fatalf("Error adding a server to %s: %s", farmName, err)
+	}
+}
+
+func testAccCheckIpLoadbalancingFarmServersDestroy(resourceType, protocol string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			farmId, err := strconv.Atoi(rs.Primary.Attributes["farm_id"])
+			if err != nil {
+				return err
+			}
+
+			config := testAccProvider.Meta().(*Config)
+			servers, err := getIpLoadbalancingFarmServers(protocol, rs.Primary.Attributes["service_name"], farmId, config.OVHClient)
+			if err == nil && len(servers) > 0 {
+				return fmt.Errorf("%s farm %d still has servers", protocol, farmId)
+			}
+		}
+		return nil
+	}
+}
@@ -10,10 +10,10 @@ import (
 
 func resourceIpLoadbalancingHttpFrontend() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpLoadbalancingHttpFrontendCreate,
-		Read:   resourceIpLoadbalancingHttpFrontendRead,
-		Update: resourceIpLoadbalancingHttpFrontendUpdate,
-		Delete: resourceIpLoadbalancingHttpFrontendDelete,
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingHttpFrontendCreate),
+		Read:          resourceIpLoadbalancingHttpFrontendRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingHttpFrontendUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingHttpFrontendDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingHttpFrontendImportState,
 		},
@@ -2,18 +2,23 @@ package ovh
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
 )
 
 func resourceIPLoadbalancingRouteHTTP() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIPLoadbalancingRouteHTTPCreate,
-		Read:   resourceIPLoadbalancingRouteHTTPRead,
-		Update: resourceIPLoadbalancingRouteHTTPUpdate,
-		Delete: resourceIPLoadbalancingRouteHTTPDelete,
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIPLoadbalancingRouteHTTPCreate),
+		Read:          resourceIPLoadbalancingRouteHTTPRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIPLoadbalancingRouteHTTPUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIPLoadbalancingRouteHTTPDelete),
+		CustomizeDiff: ipLoadbalancingRouteCustomizeDiff("http", true, true),
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingHttpRouteImportState,
 		},
@@ -59,6 +64,50 @@ func resourceIPLoadbalancingRouteHTTP() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"rules": {
+				Type:        schema.TypeList,
+				Description: "Rules of the route, changed while the route is detached from its frontend. The rules of a route without any are not managed, unless imported",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"field": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"match": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								err := helpers.ValidateIpLoadbalancingRouteRuleMatch(v.(string))
+								if err != nil {
+									errors = append(errors, err)
+								}
+								return
+							},
+						},
+						"negate": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"pattern": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sub_field": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -74,6 +123,20 @@ func resourceIpLoadbalancingHttpRouteImportState(d *schema.ResourceData, meta in
 	d.SetId(routeId)
 	d.Set("service_name", serviceName)
 
+	// rules are only read back when managed by the resource: import them
+	// along with the route, not to create them again once declared
+	config := meta.(*Config)
+	rules, err := getIPLoadbalancingRouteHTTPRules(serviceName, routeId, config.OVHClient)
+	if err != nil {
+		return nil, err
+	}
+
+	mappedRules := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		mappedRules[i] = ipLoadbalancingRouteHTTPRuleToMap(rule)
+	}
+	d.Set("rules", mappedRules)
+
 	results := make([]*schema.ResourceData, 1)
 	results[0] = d
 	return results, nil
@@ -96,6 +159,13 @@ func resourceIPLoadbalancingRouteHTTPCreate(d *schema.ResourceData, meta interfa
 		Weight:      d.Get("weight").(int),
 	}
 
+	// a route without rules matches all the traffic of its frontend,
+	// the route is attached to its frontend once its rules are created
+	rules := d.Get("rules").([]interface{})
+	if len(rules) > 0 {
+		route.FrontendID = 0
+	}
+
 	service := d.Get("service_name").(string)
 	resp := &IPLoadbalancingRouteHTTP{}
 	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route", service)
@@ -107,6 +177,22 @@ func resourceIPLoadbalancingRouteHTTPCreate(d *schema.ResourceData, meta interfa
 
 	d.SetId(fmt.Sprintf("%d", resp.RouteID))
 
+	if len(rules) > 0 {
+		if err := updateIPLoadbalancingRouteHTTPRules(service, d.Id(), nil, rules, config.OVHClient); err != nil {
+			return err
+		}
+
+		if frontendID := d.Get("frontend_id").(int); frontendID != 0 {
+			route.FrontendID = frontendID
+			endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s", service, d.Id())
+
+			err := config.OVHClient.Put(endpoint, route, nil)
+			if err != nil {
+				return fmt.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
+			}
+		}
+	}
+
 	return resourceIPLoadbalancingRouteHTTPRead(d, meta)
 }
 
@@ -135,6 +221,23 @@ func resourceIPLoadbalancingRouteHTTPRead(d *schema.ResourceData, meta interface
 	d.Set("frontend_id", r.FrontendID)
 	d.Set("action", actions)
 
+	// rules are only read back when managed by the resource, they may
+	// be managed by ovh_iploadbalancing_http_route_rule resources instead
+	if len(d.Get("rules").([]interface{})) == 0 {
+		return nil
+	}
+
+	rules, err := getIPLoadbalancingRouteHTTPRules(service, d.Id(), config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	mappedRules := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		mappedRules[i] = ipLoadbalancingRouteHTTPRuleToMap(rule)
+	}
+	d.Set("rules", mappedRules)
+
 	return nil
 }
 
@@ -157,6 +260,23 @@ func resourceIPLoadbalancingRouteHTTPUpdate(d *schema.ResourceData, meta interfa
 		Weight:      d.Get("weight").(int),
 	}
 
+	// rules are reconciled while the route is detached from its
+	// frontend, so that it never applies with only part of its rules,
+	// it is attached again by the route update
+	if d.HasChange("rules") {
+		if o, _ := d.GetChange("frontend_id"); o.(int) != 0 {
+			opts := &IPLoadbalancingRouteFrontendOpts{}
+			if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+				return fmt.Errorf("calling PUT %s with %v:\n\t %s", endpoint, opts, err.Error())
+			}
+		}
+
+		o, n := d.GetChange("rules")
+		if err := updateIPLoadbalancingRouteHTTPRules(service, d.Id(), o.([]interface{}), n.([]interface{}), config.OVHClient); err != nil {
+			return err
+		}
+	}
+
 	err := config.OVHClient.Put(endpoint, route, nil)
 	if err != nil {
 		return fmt.Errorf("calling %s:\n\t %s", endpoint, err.Error())
@@ -178,5 +298,99 @@ func resourceIPLoadbalancingRouteHTTPDelete(d *schema.ResourceData, meta interfa
 	}
 
 	d.SetId("")
+
+	return nil
+}
+
+// updateIPLoadbalancingRouteHTTPRules reconciles the rules of a route by
+// position, as rules are listed in creation order: rules which differ
+// are updated, new rules are created and rules in excess are deleted.
+func updateIPLoadbalancingRouteHTTPRules(service, routeID string, oldRules, newRules []interface{}, c *ovh.Client) error {
+	for i, v := range newRules {
+		rule := ipLoadbalancingRouteHTTPRuleFromMap(v.(map[string]interface{}))
+
+		if i >= len(oldRules) {
+			endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s/rule", service, routeID)
+
+			err := c.Post(endpoint, rule, nil)
+			if err != nil {
+				return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+			}
+			continue
+		}
+
+		old := oldRules[i].(map[string]interface{})
+		if reflect.DeepEqual(ipLoadbalancingRouteHTTPRuleFromMap(old), rule) {
+			continue
+		}
+
+		endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s/rule/%d", service, routeID, old["rule_id"].(int))
+
+		opts := (&IPLoadbalancingRouteHTTPRuleUpdateOpts{}).FromRule(rule)
+		err := c.Put(endpoint, opts, nil)
+		if err != nil {
+			return fmt.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
+		}
+	}
+
+	for i := len(newRules); i < len(oldRules); i++ {
+		old := oldRules[i].(map[string]interface{})
+		endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s/rule/%d", service, routeID, old["rule_id"].(int))
+
+		err := c.Delete(endpoint, nil)
+		if err != nil {
+			return fmt.Errorf("Error calling %s: %s \n", endpoint, err.Error())
+		}
+	}
+
 	return nil
 }
+
+// getIPLoadbalancingRouteHTTPRules returns the rules of a route in creation order.
+func getIPLoadbalancingRouteHTTPRules(service, routeID string, c *ovh.Client) ([]*IPLoadbalancingRouteHTTPRule, error) {
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s/rule", service, routeID)
+
+	ids := []int{}
+	err := c.Get(endpoint, &ids)
+	if err != nil {
+		return nil, fmt.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+	}
+
+	sort.Ints(ids)
+
+	rules := make([]*IPLoadbalancingRouteHTTPRule, len(ids))
+	for i, id := range ids {
+		endpoint := fmt.Sprintf("/ipLoadbalancing/%s/http/route/%s/rule/%d", service, routeID, id)
+
+		rules[i] = &IPLoadbalancingRouteHTTPRule{}
+		err := c.Get(endpoint, rules[i])
+		if err != nil {
+			return nil, fmt.Errorf("calling GET %s:\n\t %s", endpoint, err.Error())
+		}
+	}
+
+	return rules, nil
+}
+
+func ipLoadbalancingRouteHTTPRuleFromMap(m map[string]interface{}) *IPLoadbalancingRouteHTTPRule {
+	return &IPLoadbalancingRouteHTTPRule{
+		DisplayName: m["display_name"].(string),
+		Field:       m["field"].(string),
+		Match:       m["match"].(string),
+		Negate:      m["negate"].(bool),
+		Pattern:     m["pattern"].(string),
+		SubField:    m["sub_field"].(string),
+	}
+}
+
+func ipLoadbalancingRouteHTTPRuleToMap(rule *IPLoadbalancingRouteHTTPRule) map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["rule_id"] = rule.RuleID
+	obj["display_name"] = rule.DisplayName
+	obj["field"] = rule.Field
+	obj["match"] = rule.Match
+	obj["negate"] = rule.Negate
+	obj["pattern"] = rule.Pattern
+	obj["sub_field"] = rule.SubField
+	return obj
+}
@@ -10,10 +10,11 @@ import (
 
 func resourceIPLoadbalancingRouteHTTPRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIPLoadbalancingRouteHTTPRuleCreate,
-		Read:   resourceIPLoadbalancingRouteHTTPRuleRead,
-		Update: resourceIPLoadbalancingRouteHTTPRuleUpdate,
-		Delete: resourceIPLoadbalancingRouteHTTPRuleDelete,
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIPLoadbalancingRouteHTTPRuleCreate),
+		Read:          resourceIPLoadbalancingRouteHTTPRuleRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIPLoadbalancingRouteHTTPRuleUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIPLoadbalancingRouteHTTPRuleDelete),
+		CustomizeDiff: ipLoadbalancingRouteRuleCustomizeDiff("http"),
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingHttpRouteRuleImportState,
 		},
@@ -41,7 +42,7 @@ func resourceIPLoadbalancingRouteHTTPRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-					err := helpers.ValidateStringEnum(v.(string), []string{"contains", "endswith", "exists", "in", "internal", "is", "matches", "startswith"})
+					err := helpers.ValidateIpLoadbalancingRouteRuleMatch(v.(string))
 					if err != nil {
 						errors = append(errors, err)
 					}
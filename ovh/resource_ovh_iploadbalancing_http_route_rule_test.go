@@ -3,6 +3,7 @@ package ovh
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -61,6 +62,33 @@ func TestAccIPLoadbalancingRouteHTTPRuleBasicCreate(t *testing.T) {
 	})
 }
 
+func TestAccIPLoadbalancingRouteHTTPRuleInvalid(t *testing.T) {
+	serviceName := os.Getenv("OVH_IPLB_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckIpLoadbalancingRouteHTTPRulePreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					testAccCheckOvhIpLoadbalancingHttpRouteRuleConfig_basic,
+					serviceName, "Test rule", "unknown", "is", "false", "example.com", "",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not among available fields"),
+			},
+			{
+				Config: fmt.Sprintf(
+					testAccCheckOvhIpLoadbalancingHttpRouteRuleConfig_basic,
+					serviceName, "Test rule", "header", "is", "false", "example.com", "",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("requires a sub field"),
+			},
+		},
+	})
+}
+
 func testAccCheckIpLoadbalancingRouteHTTPRulePreCheck(t *testing.T) {
 	testAccPreCheckIpLoadbalancing(t)
 	testAccCheckIpLoadbalancingExists(t)
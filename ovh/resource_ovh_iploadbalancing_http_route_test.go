@@ -3,9 +3,11 @@ package ovh
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -56,11 +58,121 @@ func TestAccIPLoadbalancingRouteHTTPBasicCreate(t *testing.T) {
 	})
 }
 
+func TestAccIPLoadbalancingRouteHTTPWithRules(t *testing.T) {
+	serviceName := os.Getenv("OVH_IPLB_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckIpLoadbalancingRouteHTTPPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIPLoadbalancingRouteHTTPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingHttpRouteConfig_rules, serviceName, name, serviceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ovh_iploadbalancing_http_route.testroute", "frontend_id",
+						"ovh_iploadbalancing_http_frontend.testfrontend", "id"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.0.field", "host"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.1.sub_field", "X-Forwarded-Proto"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_iploadbalancing_http_route.testroute", "rules.0.rule_id"),
+				),
+			},
+			{
+				ResourceName:      "ovh_iploadbalancing_http_route.testroute",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccIpLoadbalancingHttpRouteImportId("ovh_iploadbalancing_http_route.testroute"),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingHttpRouteConfig_rulesUpdated, serviceName, name, serviceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.0.pattern", "www.example.com"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.0.negate", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingHttpRouteConfig_rulesCleared, serviceName, name, serviceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.0.negate", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingHttpRouteConfig_noRules, serviceName, name, serviceName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_http_route.testroute", "rules.#", "0"),
+					testAccCheckIPLoadbalancingRouteHTTPRulesDeleted("ovh_iploadbalancing_http_route.testroute"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIPLoadbalancingRouteHTTPInvalidAction(t *testing.T) {
+	serviceName := os.Getenv("OVH_IPLB_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckIpLoadbalancingRouteHTTPPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					testAccCheckOvhIpLoadbalancingHttpRouteConfig_basic,
+					serviceName, "test-route-invalid", "0", "302", "https://$${hostname}$${path}", "redirect",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid variable"),
+			},
+			{
+				Config: fmt.Sprintf(
+					testAccCheckOvhIpLoadbalancingHttpRouteConfig_basic,
+					serviceName, "test-route-invalid", "0", "200", "https://$${host}$${path}", "redirect",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not among valid values"),
+			},
+		},
+	})
+}
+
 func testAccCheckIpLoadbalancingRouteHTTPPreCheck(t *testing.T) {
 	testAccPreCheckIpLoadbalancing(t)
 	testAccCheckIpLoadbalancingExists(t)
 }
 
+func testAccCheckIPLoadbalancingRouteHTTPRulesDeleted(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		rules, err := getIPLoadbalancingRouteHTTPRules(os.Getenv("OVH_IPLB_SERVICE"), rs.Primary.ID, config.OVHClient)
+		if err != nil {
+			return err
+		}
+
+		if len(rules) > 0 {
+			return fmt.Errorf("IpLoadbalancing route %s still has %d rules", rs.Primary.ID, len(rules))
+		}
+		return nil
+	}
+}
+
 func testAccCheckIPLoadbalancingRouteHTTPDestroy(state *terraform.State) error {
 	for _, resource := range state.RootModule().Resources {
 		if resource.Type != "ovh_iploadbalancing_http_route" {
@@ -90,3 +202,109 @@ resource "ovh_iploadbalancing_http_route" "testroute" {
 	}
 }
 `
+
+const testAccCheckOvhIpLoadbalancingHttpRouteConfig_rules = `
+resource "ovh_iploadbalancing_http_frontend" "testfrontend" {
+  service_name = "%s"
+  display_name = "%s"
+  zone         = "all"
+  port         = "22280"
+}
+
+resource "ovh_iploadbalancing_http_route" "testroute" {
+  service_name = "%s"
+  display_name = "%s"
+  frontend_id  = ovh_iploadbalancing_http_frontend.testfrontend.id
+
+  action {
+    status = 403
+    type   = "reject"
+  }
+
+  rules {
+    field   = "host"
+    match   = "is"
+    pattern = "example.com"
+  }
+
+  rules {
+    field     = "header"
+    sub_field = "X-Forwarded-Proto"
+    match     = "is"
+    pattern   = "http"
+  }
+}
+`
+
+const testAccCheckOvhIpLoadbalancingHttpRouteConfig_rulesUpdated = `
+resource "ovh_iploadbalancing_http_frontend" "testfrontend" {
+  service_name = "%s"
+  display_name = "%s"
+  zone         = "all"
+  port         = "22280"
+}
+
+resource "ovh_iploadbalancing_http_route" "testroute" {
+  service_name = "%s"
+  display_name = "%s"
+  frontend_id  = ovh_iploadbalancing_http_frontend.testfrontend.id
+
+  action {
+    status = 403
+    type   = "reject"
+  }
+
+  rules {
+    field   = "host"
+    match   = "is"
+    negate  = true
+    pattern = "www.example.com"
+  }
+}
+`
+
+const testAccCheckOvhIpLoadbalancingHttpRouteConfig_rulesCleared = `
+resource "ovh_iploadbalancing_http_frontend" "testfrontend" {
+  service_name = "%s"
+  display_name = "%s"
+  zone         = "all"
+  port         = "22280"
+}
+
+resource "ovh_iploadbalancing_http_route" "testroute" {
+  service_name = "%s"
+  display_name = "%s"
+  frontend_id  = ovh_iploadbalancing_http_frontend.testfrontend.id
+
+  action {
+    status = 403
+    type   = "reject"
+  }
+
+  rules {
+    field   = "host"
+    match   = "is"
+    pattern = "www.example.com"
+  }
+}
+`
+
+const testAccCheckOvhIpLoadbalancingHttpRouteConfig_noRules = `
+resource "ovh_iploadbalancing_http_frontend" "testfrontend" {
+  service_name = "%s"
+  display_name = "%s"
+  zone         = "all"
+  port         = "22280"
+}
+
+resource "ovh_iploadbalancing_http_route" "testroute" {
+  service_name = "%s"
+  display_name = "%s"
+  frontend_id  = ovh_iploadbalancing_http_frontend.testfrontend.id
+
+  action {
+    status = 403
+    type   = "reject"
+  }
+}
+`
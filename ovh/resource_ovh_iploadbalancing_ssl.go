@@ -0,0 +1,286 @@
+package ovh
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpLoadbalancingSsl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpLoadbalancingSslCreate,
+		Read:   resourceIpLoadbalancingSslRead,
+		Update: resourceIpLoadbalancingSslUpdate,
+		Delete: resourceIpLoadbalancingSslDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpLoadbalancingSslImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "The internal name of your IP load balancing",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"certificate": {
+				Type:             schema.TypeString,
+				Description:      "PEM encoded certificate",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ipLoadbalancingSslImportedDiffSuppress,
+			},
+			"key": {
+				Type:             schema.TypeString,
+				Description:      "PEM encoded private key of the certificate",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ipLoadbalancingSslImportedDiffSuppress,
+				Sensitive:        true,
+			},
+			"chain": {
+				Type:             schema.TypeString,
+				Description:      "PEM encoded intermediate certificates chain",
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ipLoadbalancingSslImportedDiffSuppress,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Human readable name for your certificate, this field is for you",
+				Optional:    true,
+			},
+
+			//Computed
+			"expire_date": {
+				Type:        schema.TypeString,
+				Description: "Expiration date of the certificate",
+				Computed:    true,
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Description: "Fingerprint of the certificate",
+				Computed:    true,
+			},
+			"san": {
+				Type:        schema.TypeList,
+				Description: "Subject alternative names of the certificate",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"serial": {
+				Type:        schema.TypeString,
+				Description: "Serial of the certificate",
+				Computed:    true,
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Description: "Subject of the certificate",
+				Computed:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Type of the certificate: built, custom or external",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIpLoadbalancingSslImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not service_name/ssl id formatted")
+	}
+	serviceName := splitId[0]
+	sslId := splitId[1]
+	d.SetId(sslId)
+	d.Set("service_name", serviceName)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+// ipLoadbalancingSslImportedDiffSuppress keeps an imported certificate from
+// being replaced: its certificate, key and chain can't be read back from the
+// API, they are only compared to the configured ones through the serial of
+// the configured certificate. Certificates created by the resource always
+// have their certificate in state, and are never suppressed.
+func ipLoadbalancingSslImportedDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if old != "" || d.Id() == "" {
+		return false
+	}
+
+	if stateCertificate, _ := d.GetChange("certificate"); stateCertificate.(string) != "" {
+		return false
+	}
+
+	return ipLoadbalancingSslSerialMatches(d.Get("certificate").(string), d.Get("serial").(string))
+}
+
+// ipLoadbalancingSslSerialMatches tells whether a PEM encoded certificate has
+// the given serial, either in hexadecimal, with or without colons, or in
+// decimal.
+func ipLoadbalancingSslSerialMatches(certificate, serial string) bool {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil || serial == "" {
+		return false
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false
+	}
+
+	serial = strings.ToLower(strings.Replace(serial, ":", "", -1))
+	if serial == cert.SerialNumber.String() {
+		return true
+	}
+
+	return strings.TrimLeft(serial, "0") == strings.TrimLeft(cert.SerialNumber.Text(16), "0")
+}
+
+func resourceIpLoadbalancingSslCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	opts := (&IpLoadbalancingSslCreateOpts{}).FromResource(d)
+	ssl := &IpLoadbalancingSsl{}
+
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/ssl",
+		url.PathEscape(serviceName),
+	)
+
+	// don't log the opts, they hold the private key
+	if err := config.OVHClient.Post(endpoint, opts, ssl); err != nil {
+		return fmt.Errorf("Error calling POST %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", ssl.Id))
+
+	return resourceIpLoadbalancingSslRead(d, meta)
+}
+
+func resourceIpLoadbalancingSslRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	ssl, err := getIpLoadbalancingSsl(serviceName, d.Id(), config.OVHClient)
+	if err != nil {
+		if errOvh, ok := err.(*ovh.APIError); ok && errOvh.Code == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading ssl %s of %s:\n\t %q", d.Id(), serviceName, err)
+	}
+
+	for k, v := range ssl.ToMap() {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func resourceIpLoadbalancingSslUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("display_name") {
+		if err := updateIpLoadbalancingSslDisplayName(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceIpLoadbalancingSslRead(d, meta)
+}
+
+func resourceIpLoadbalancingSslDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/ssl/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, nil); err != nil {
+		return fmt.Errorf("Error calling DELETE %s:\n\t %q", endpoint, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getIpLoadbalancingSsl returns a certificate of an IP load balancing.
+// API errors are returned as is, so that callers can check whether the
+// certificate exists.
+func getIpLoadbalancingSsl(serviceName, sslId string, c *ovh.Client) (*IpLoadbalancingSsl, error) {
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/ssl/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(sslId),
+	)
+
+	ssl := &IpLoadbalancingSsl{}
+	if err := c.Get(endpoint, ssl); err != nil {
+		return nil, err
+	}
+
+	return ssl, nil
+}
+
+func updateIpLoadbalancingSslDisplayName(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/ssl/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(d.Id()),
+	)
+
+	opts := (&IpLoadbalancingSslUpdateOpts{}).FromResource(d)
+	if err := config.OVHClient.Put(endpoint, opts, nil); err != nil {
+		return fmt.Errorf("Error calling PUT %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	return nil
+}
+
+// listIpLoadbalancingSsls returns the certificates of an IP load balancing,
+// optionally filtered on their type.
+func listIpLoadbalancingSsls(serviceName, sslType string, c *ovh.Client) ([]*IpLoadbalancingSsl, error) {
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/ssl",
+		url.PathEscape(serviceName),
+	)
+
+	if sslType != "" {
+		query := url.Values{}
+		query.Set("type", sslType)
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	ids := []int{}
+	if err := c.Get(endpoint, &ids); err != nil {
+		return nil, fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	ssls := make([]*IpLoadbalancingSsl, len(ids))
+	for i, id := range ids {
+		ssl, err := getIpLoadbalancingSsl(serviceName, fmt.Sprintf("%d", id), c)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading ssl %d of %s:\n\t %q", id, serviceName, err)
+		}
+		ssls[i] = ssl
+	}
+
+	return ssls, nil
+}
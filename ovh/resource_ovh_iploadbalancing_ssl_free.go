@@ -0,0 +1,190 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceIpLoadbalancingSslFree() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIpLoadbalancingSslFreeCreate,
+		Read:   resourceIpLoadbalancingSslRead,
+		Update: resourceIpLoadbalancingSslUpdate,
+		Delete: resourceIpLoadbalancingSslDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceIpLoadbalancingSslFreeImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "The internal name of your IP load balancing",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"fqdn": {
+				Type:        schema.TypeList,
+				Description: "Domain names the certificate is issued for. They must point to the IP load balancing",
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Human readable name for your certificate, this field is for you",
+				Optional:    true,
+			},
+
+			//Computed
+			"expire_date": {
+				Type:        schema.TypeString,
+				Description: "Expiration date of the certificate",
+				Computed:    true,
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Description: "Fingerprint of the certificate",
+				Computed:    true,
+			},
+			"san": {
+				Type:        schema.TypeList,
+				Description: "Subject alternative names of the certificate",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"serial": {
+				Type:        schema.TypeString,
+				Description: "Serial of the certificate",
+				Computed:    true,
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Description: "Subject of the certificate",
+				Computed:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Type of the certificate: built, custom or external",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// resourceIpLoadbalancingSslFreeImportState imports a free certificate, its
+// fqdn are the subject alternative names it was issued for.
+func resourceIpLoadbalancingSslFreeImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	if _, err := resourceIpLoadbalancingSslImportState(d, meta); err != nil {
+		return nil, err
+	}
+	serviceName := d.Get("service_name").(string)
+
+	ssl, err := getIpLoadbalancingSsl(serviceName, d.Id(), config.OVHClient)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading ssl %s of %s:\n\t %q", d.Id(), serviceName, err)
+	}
+
+	if ssl.Type != "built" {
+		return nil, fmt.Errorf("Certificate %s of %s is not a free certificate, its type is %s", d.Id(), serviceName, ssl.Type)
+	}
+	d.Set("fqdn", ssl.San)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpLoadbalancingSslFreeCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	serviceName := d.Get("service_name").(string)
+
+	fqdn, err := helpers.StringsFromSchema(d, "fqdn")
+	if err != nil {
+		return err
+	}
+
+	opts := &IpLoadbalancingFreeCertificateOpts{Fqdn: fqdn}
+	task := &IPLoadbalancingRefreshTask{}
+
+	endpoint := fmt.Sprintf(
+		"/ipLoadbalancing/%s/freeCertificate",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForIpLoadbalancingTask(serviceName, task.ID, config.OVHClient); err != nil {
+		return fmt.Errorf("Error waiting for free certificate of %s for %s: %s", serviceName, strings.Join(fqdn, ", "), err)
+	}
+
+	// the task doesn't return the certificate, look for the most
+	// recent built one covering all the domain names
+	ssl, err := findIpLoadbalancingSslFree(serviceName, fqdn, config.OVHClient)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Free certificate %d issued on %s for %s", ssl.Id, serviceName, strings.Join(fqdn, ", "))
+	d.SetId(fmt.Sprintf("%d", ssl.Id))
+
+	if _, ok := d.GetOk("display_name"); ok {
+		if err := updateIpLoadbalancingSslDisplayName(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceIpLoadbalancingSslRead(d, meta)
+}
+
+func findIpLoadbalancingSslFree(serviceName string, fqdn []string, c *ovh.Client) (*IpLoadbalancingSsl, error) {
+	ssls, err := listIpLoadbalancingSsls(serviceName, "built", c)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *IpLoadbalancingSsl
+	for _, ssl := range ssls {
+		if !ipLoadbalancingSslCovers(ssl, fqdn) {
+			continue
+		}
+		if found == nil || ssl.Id > found.Id {
+			found = ssl
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("No free certificate found on %s for %s", serviceName, strings.Join(fqdn, ", "))
+	}
+
+	return found, nil
+}
+
+// ipLoadbalancingSslCovers checks whether all the names are
+// subject alternative names of the certificate.
+func ipLoadbalancingSslCovers(ssl *IpLoadbalancingSsl, names []string) bool {
+	for _, name := range names {
+		covered := false
+		for _, san := range ssl.San {
+			if strings.EqualFold(san, name) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
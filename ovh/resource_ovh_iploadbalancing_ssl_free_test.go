@@ -0,0 +1,40 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpLoadbalancingSslFree_basic(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+	fqdn := os.Getenv("OVH_IPLB_SSL_FREE_FQDN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpLoadbalancingSslFree(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingSslFreeConfig, iplb, fqdn, test_prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_ssl_free.cert", "type", "built"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_ssl_free.cert", "display_name", test_prefix),
+					resource.TestCheckResourceAttrSet(
+						"ovh_iploadbalancing_ssl_free.cert", "fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+const testAccIpLoadbalancingSslFreeConfig = `
+resource "ovh_iploadbalancing_ssl_free" "cert" {
+  service_name = "%s"
+  fqdn         = ["%s"]
+  display_name = "%s"
+}
+`
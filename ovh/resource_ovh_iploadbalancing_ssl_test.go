@@ -0,0 +1,233 @@
+package ovh
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIpLoadbalancingSsl_basic(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+	fqdn := fmt.Sprintf("%s.example.com", name)
+	certificate, key := testAccIpLoadbalancingSslSelfSigned(t, fqdn)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckIpLoadbalancingSslPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpLoadbalancingSslDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingSslConfig, iplb, certificate, key, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_ssl.cert", "display_name", name),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_ssl.cert", "type", "custom"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_ssl.cert", "san.0", fqdn),
+					resource.TestCheckResourceAttrSet(
+						"ovh_iploadbalancing_ssl.cert", "fingerprint"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_iploadbalancing_ssl.cert", "expire_date"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingSslConfig, iplb, certificate, key, name+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_ssl.cert", "display_name", name+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIpLoadbalancingSsl_chainAddedLater(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+	certificate, key, chain := testAccIpLoadbalancingSslSignedWithChain(t, fmt.Sprintf("%s.example.com", name))
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckIpLoadbalancingSslPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpLoadbalancingSslDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingSslConfig, iplb, certificate, key, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_ssl.cert", "chain", ""),
+					testAccIpLoadbalancingSslId("ovh_iploadbalancing_ssl.cert", &id),
+				),
+			},
+			{
+				// adding a chain replaces the certificate
+				Config: fmt.Sprintf(testAccIpLoadbalancingSslConfig_chain, iplb, certificate, key, chain, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_ssl.cert", "chain", chain),
+					func(s *terraform.State) error {
+						if rs := s.RootModule().Resources["ovh_iploadbalancing_ssl.cert"]; rs.Primary.ID == id {
+							return fmt.Errorf("Certificate %s was not replaced when adding its chain", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccIpLoadbalancingSslId(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckIpLoadbalancingSslPreCheck(t *testing.T) {
+	testAccPreCheckIpLoadbalancing(t)
+	testAccCheckIpLoadbalancingExists(t)
+}
+
+func testAccCheckIpLoadbalancingSslDestroy(state *terraform.State) error {
+	for _, resource := range state.RootModule().Resources {
+		if resource.Type != "ovh_iploadbalancing_ssl" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		endpoint := fmt.Sprintf(
+			"/ipLoadbalancing/%s/ssl/%s",
+			os.Getenv("OVH_IPLB_SERVICE"),
+			resource.Primary.ID,
+		)
+
+		err := config.OVHClient.Get(endpoint, nil)
+		if err == nil {
+			return fmt.Errorf("IpLoadbalancing ssl still exists")
+		}
+	}
+	return nil
+}
+
+// testAccIpLoadbalancingSslSelfSigned generates a PEM encoded self signed
+// certificate for the given name, and its private key.
+func testAccIpLoadbalancingSslSelfSigned(t *testing.T, name string) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Error generating certificate: %s", err)
+	}
+
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return string(certificate), string(privateKey)
+}
+
+// testAccIpLoadbalancingSslSignedWithChain generates a PEM encoded
+// certificate for the given name signed by a self signed CA, its private
+// key and the CA certificate as chain.
+func testAccIpLoadbalancingSslSignedWithChain(t *testing.T, name string) (string, string, string) {
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating key: %s", err)
+	}
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "CA of " + name},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("Error generating CA certificate: %s", err)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("Error generating certificate: %s", err)
+	}
+
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	chain := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDer})
+
+	return string(certificate), string(privateKey), string(chain)
+}
+
+const testAccIpLoadbalancingSslConfig = `
+resource "ovh_iploadbalancing_ssl" "cert" {
+  service_name = "%s"
+  certificate  = <<EOT
+%sEOT
+  key          = <<EOT
+%sEOT
+  display_name = "%s"
+}
+`
+
+const testAccIpLoadbalancingSslConfig_chain = `
+resource "ovh_iploadbalancing_ssl" "cert" {
+  service_name = "%s"
+  certificate  = <<EOT
+%sEOT
+  key          = <<EOT
+%sEOT
+  chain        = <<EOT
+%sEOT
+  display_name = "%s"
+}
+`
@@ -10,10 +10,10 @@ import (
 
 func resourceIpLoadbalancingTcpFarm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpLoadbalancingTcpFarmCreate,
-		Read:   resourceIpLoadbalancingTcpFarmRead,
-		Update: resourceIpLoadbalancingTcpFarmUpdate,
-		Delete: resourceIpLoadbalancingTcpFarmDelete,
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingTcpFarmCreate),
+		Read:          resourceIpLoadbalancingTcpFarmRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingTcpFarmUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingTcpFarmDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingTcpFarmImportState,
 		},
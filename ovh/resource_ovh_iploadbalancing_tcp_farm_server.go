@@ -12,10 +12,10 @@ import (
 
 func resourceIpLoadbalancingTcpFarmServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpLoadbalancingTcpFarmServerCreate,
-		Read:   resourceIpLoadbalancingTcpFarmServerRead,
-		Update: resourceIpLoadbalancingTcpFarmServerUpdate,
-		Delete: resourceIpLoadbalancingTcpFarmServerDelete,
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingTcpFarmServerCreate),
+		Read:          resourceIpLoadbalancingTcpFarmServerRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingTcpFarmServerUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingTcpFarmServerDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingTcpFarmServerImportState,
 		},
@@ -201,6 +201,7 @@ func resourceIpLoadbalancingTcpFarmServerUpdate(d *schema.ResourceData, meta int
 	if err != nil {
 		return fmt.Errorf("calling PUT %s with %d:\n\t %s", endpoint, farmid, err.Error())
 	}
+
 	return resourceIpLoadbalancingTcpFarmServerRead(d, meta)
 }
 
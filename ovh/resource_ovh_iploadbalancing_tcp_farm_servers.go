@@ -0,0 +1,9 @@
+package ovh
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIpLoadbalancingTcpFarmServers() *schema.Resource {
+	return resourceIpLoadbalancingFarmServers("tcp")
+}
@@ -0,0 +1,89 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	testAccIpLoadbalancingTcpFarmServersConfig_templ = `
+data ovh_iploadbalancing iplb {
+  service_name = "%s"
+}
+
+resource ovh_iploadbalancing_tcp_farm testacc {
+  service_name = data.ovh_iploadbalancing.iplb.id
+  display_name = "%s"
+  port         = 8080
+  zone         = "all"
+}
+`
+	testAccIpLoadbalancingTcpFarmServersConfig_step0 = `
+%s
+
+resource ovh_iploadbalancing_tcp_farm_servers testacc {
+  service_name = data.ovh_iploadbalancing.iplb.id
+  farm_id      = ovh_iploadbalancing_tcp_farm.testacc.id
+
+  server {
+    address                = "10.0.0.11"
+    proxy_protocol_version = "v2"
+  }
+
+  server {
+    address = "10.0.0.11"
+    port    = 8081
+    weight  = 2
+  }
+}
+`
+	testAccIpLoadbalancingTcpFarmServersConfig_step1 = `
+%s
+
+resource ovh_iploadbalancing_tcp_farm_servers testacc {
+  service_name = data.ovh_iploadbalancing.iplb.id
+  farm_id      = ovh_iploadbalancing_tcp_farm.testacc.id
+
+  server {
+    address = "10.0.0.11"
+    port    = 8081
+    weight  = 3
+    backup  = true
+  }
+}
+`
+)
+
+func TestAccIpLoadbalancingTcpFarmServers_basic(t *testing.T) {
+	prefix := fmt.Sprintf(
+		testAccIpLoadbalancingTcpFarmServersConfig_templ,
+		os.Getenv("OVH_IPLB_SERVICE"),
+		acctest.RandomWithPrefix(test_prefix),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckIpLoadbalancing(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpLoadbalancingFarmServersDestroy("ovh_iploadbalancing_tcp_farm_servers", "tcp"),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingTcpFarmServersConfig_step0, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_tcp_farm_servers.testacc", "server.#", "2"),
+					testAccCheckIpLoadbalancingFarmServersCount("ovh_iploadbalancing_tcp_farm_servers.testacc", "tcp", 2),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingTcpFarmServersConfig_step1, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_tcp_farm_servers.testacc", "server.#", "1"),
+					testAccCheckIpLoadbalancingFarmServersCount("ovh_iploadbalancing_tcp_farm_servers.testacc", "tcp", 1),
+				),
+			},
+		},
+	})
+}
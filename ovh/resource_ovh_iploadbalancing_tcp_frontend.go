@@ -10,10 +10,10 @@ import (
 
 func resourceIpLoadbalancingTcpFrontend() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIpLoadbalancingTcpFrontendCreate,
-		Read:   resourceIpLoadbalancingTcpFrontendRead,
-		Update: resourceIpLoadbalancingTcpFrontendUpdate,
-		Delete: resourceIpLoadbalancingTcpFrontendDelete,
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingTcpFrontendCreate),
+		Read:          resourceIpLoadbalancingTcpFrontendRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingTcpFrontendUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingTcpFrontendDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceIpLoadbalancingTcpFrontendImportState,
 		},
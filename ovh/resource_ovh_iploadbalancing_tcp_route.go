@@ -0,0 +1,176 @@
+package ovh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceIPLoadbalancingRouteTCP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIPLoadbalancingRouteTCPCreate),
+		Read:          resourceIPLoadbalancingRouteTCPRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIPLoadbalancingRouteTCPUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIPLoadbalancingRouteTCPDelete),
+		CustomizeDiff: ipLoadbalancingRouteCustomizeDiff("tcp", false, false),
+		Importer: &schema.ResourceImporter{
+			State: resourceIpLoadbalancingTcpRouteImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"action": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: false,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"frontend_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"weight": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceIpLoadbalancingTcpRouteImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not service_name/route id formatted")
+	}
+	serviceName := splitId[0]
+	routeId := splitId[1]
+	d.SetId(routeId)
+	d.Set("service_name", serviceName)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIPLoadbalancingRouteTCPCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	action := &IPLoadbalancingRouteTCPAction{}
+	actionSet := d.Get("action").([]interface{})[0].(map[string]interface{})
+
+	action.Target = actionSet["target"].(string)
+	action.Type = actionSet["type"].(string)
+
+	route := &IPLoadbalancingRouteTCP{
+		Action:      action,
+		DisplayName: d.Get("display_name").(string),
+		FrontendID:  d.Get("frontend_id").(int),
+		Weight:      d.Get("weight").(int),
+	}
+
+	service := d.Get("service_name").(string)
+	resp := &IPLoadbalancingRouteTCP{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/route", service)
+
+	err := config.OVHClient.Post(endpoint, route, resp)
+	if err != nil {
+		return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+	}
+
+	d.SetId(fmt.Sprintf("%d", resp.RouteID))
+
+	return resourceIPLoadbalancingRouteTCPRead(d, meta)
+}
+
+func resourceIPLoadbalancingRouteTCPRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	r := &IPLoadbalancingRouteTCP{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/route/%s", service, d.Id())
+
+	err := config.OVHClient.Get(endpoint, &r)
+	if err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId(fmt.Sprintf("%d", r.RouteID))
+
+	actions := make([]map[string]interface{}, 0)
+	action := make(map[string]interface{})
+	action["target"] = r.Action.Target
+	action["type"] = r.Action.Type
+	actions = append(actions, action)
+
+	d.Set("weight", r.Weight)
+	d.Set("display_name", r.DisplayName)
+	d.Set("frontend_id", r.FrontendID)
+	d.Set("action", actions)
+
+	return nil
+}
+
+func resourceIPLoadbalancingRouteTCPUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/route/%s", service, d.Id())
+
+	action := &IPLoadbalancingRouteTCPAction{}
+	actionSet := d.Get("action").([]interface{})[0].(map[string]interface{})
+
+	action.Target = actionSet["target"].(string)
+	action.Type = actionSet["type"].(string)
+
+	route := &IPLoadbalancingRouteTCP{
+		Action:      action,
+		DisplayName: d.Get("display_name").(string),
+		FrontendID:  d.Get("frontend_id").(int),
+		Weight:      d.Get("weight").(int),
+	}
+
+	err := config.OVHClient.Put(endpoint, route, nil)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %s", endpoint, err.Error())
+	}
+
+	return resourceIPLoadbalancingRouteTCPRead(d, meta)
+}
+
+func resourceIPLoadbalancingRouteTCPDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	service := d.Get("service_name").(string)
+	r := &IPLoadbalancingRouteTCP{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/route/%s", service, d.Id())
+
+	err := config.OVHClient.Delete(endpoint, &r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s: %s \n", endpoint, err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,174 @@
+package ovh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceIPLoadbalancingRouteTCPRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIPLoadbalancingRouteTCPRuleCreate),
+		Read:          resourceIPLoadbalancingRouteTCPRuleRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIPLoadbalancingRouteTCPRuleUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIPLoadbalancingRouteTCPRuleDelete),
+		CustomizeDiff: ipLoadbalancingRouteRuleCustomizeDiff("tcp"),
+		Importer: &schema.ResourceImporter{
+			State: resourceIpLoadbalancingTcpRouteRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"field": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"match": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpLoadbalancingRouteRuleMatch(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"negate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"pattern": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sub_field": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceIpLoadbalancingTcpRouteRuleImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not service_name/route_id/rule id formatted")
+	}
+	serviceName := splitId[0]
+	routeID := splitId[1]
+	ruleID := splitId[2]
+
+	d.SetId(ruleID)
+	d.Set("route_id", routeID)
+	d.Set("service_name", serviceName)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIPLoadbalancingRouteTCPRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	rule := &IPLoadbalancingRouteTCPRule{
+		DisplayName: d.Get("display_name").(string),
+		Field:       d.Get("field").(string),
+		Match:       d.Get("match").(string),
+		Negate:      d.Get("negate").(bool),
+		Pattern:     d.Get("pattern").(string),
+		SubField:    d.Get("sub_field").(string),
+	}
+
+	service := d.Get("service_name").(string)
+	routeID := d.Get("route_id").(string)
+	resp := &IPLoadbalancingRouteTCPRule{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/route/%s/rule", service, routeID)
+
+	err := config.OVHClient.Post(endpoint, rule, resp)
+	if err != nil {
+		return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+	}
+
+	d.SetId(fmt.Sprintf("%d", resp.RuleID))
+
+	return resourceIPLoadbalancingRouteTCPRuleRead(d, meta)
+}
+
+func resourceIPLoadbalancingRouteTCPRuleRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	routeID := d.Get("route_id").(string)
+	r := &IPLoadbalancingRouteTCPRule{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/route/%s/rule/%s", service, routeID, d.Id())
+	err := config.OVHClient.Get(endpoint, &r)
+	if err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("display_name", r.DisplayName)
+	d.Set("field", r.Field)
+	d.Set("match", r.Match)
+	d.Set("negate", r.Negate)
+	d.Set("pattern", r.Pattern)
+	d.Set("sub_field", r.SubField)
+
+	return nil
+}
+
+func resourceIPLoadbalancingRouteTCPRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	routeID := d.Get("route_id").(string)
+
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/route/%s/rule/%s", service, routeID, d.Id())
+
+	rule := &IPLoadbalancingRouteTCPRule{
+		DisplayName: d.Get("display_name").(string),
+		Field:       d.Get("field").(string),
+		Match:       d.Get("match").(string),
+		Negate:      d.Get("negate").(bool),
+		Pattern:     d.Get("pattern").(string),
+		SubField:    d.Get("sub_field").(string),
+	}
+
+	err := config.OVHClient.Put(endpoint, rule, nil)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %s", endpoint, err.Error())
+	}
+
+	return resourceIPLoadbalancingRouteTCPRuleRead(d, meta)
+}
+
+func resourceIPLoadbalancingRouteTCPRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	service := d.Get("service_name").(string)
+	routeID := d.Get("route_id").(string)
+
+	r := &IPLoadbalancingRouteTCPRule{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/route/%s/rule/%s", service, routeID, d.Id())
+
+	err := config.OVHClient.Delete(endpoint, &r)
+	if err != nil {
+		return fmt.Errorf("Error calling %s: %s \n", endpoint, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIPLoadbalancingRouteTCPRuleBasicCreate(t *testing.T) {
+	serviceName := os.Getenv("OVH_IPLB_SERVICE")
+	displayName := "Test rule"
+	field := "sni"
+	match := "is"
+	negate := "false"
+	pattern := "example.com"
+
+	config := fmt.Sprintf(
+		testAccCheckOvhIpLoadbalancingTcpRouteRuleConfig_basic,
+		serviceName,
+		displayName,
+		field,
+		match,
+		negate,
+		pattern,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckIpLoadbalancingRouteTCPRulePreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIPLoadbalancingRouteTCPRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route.testroute", "service_name", serviceName),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route.testroute", "display_name", displayName),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route_rule.testrule", "service_name", serviceName),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route_rule.testrule", "display_name", displayName),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route_rule.testrule", "field", field),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route_rule.testrule", "match", match),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route_rule.testrule", "negate", negate),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route_rule.testrule", "pattern", pattern),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpLoadbalancingRouteTCPRulePreCheck(t *testing.T) {
+	testAccPreCheckIpLoadbalancing(t)
+	testAccCheckIpLoadbalancingExists(t)
+}
+
+func testAccCheckIPLoadbalancingRouteTCPRuleDestroy(state *terraform.State) error {
+	for _, resource := range state.RootModule().Resources {
+		if resource.Type != "ovh_iploadbalancing_tcp_route_rule" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		endpoint := fmt.Sprintf(
+			"/ipLoadbalancing/%s/tcp/route/%s/rule/%s",
+			os.Getenv("OVH_IPLB_SERVICE"),
+			resource.Primary.Attributes["route_id"],
+			resource.Primary.ID,
+		)
+
+		err := config.OVHClient.Get(endpoint, nil)
+		if err == nil {
+			return fmt.Errorf("IpLoadbalancing tcp route rule still exists")
+		}
+	}
+	return nil
+}
+
+const testAccCheckOvhIpLoadbalancingTcpRouteRuleConfig_basic = `
+resource "ovh_iploadbalancing_tcp_route" "testroute" {
+	service_name = "%s"
+	display_name = "%s"
+	weight = 0
+
+	action {
+		type = "reject"
+	}
+}
+
+resource "ovh_iploadbalancing_tcp_route_rule" "testrule" {
+	service_name = "${ovh_iploadbalancing_tcp_route.testroute.service_name}"
+	route_id  = "${ovh_iploadbalancing_tcp_route.testroute.id}"
+	display_name = "${ovh_iploadbalancing_tcp_route.testroute.display_name}"
+	field = "%s"
+	match = "%s"
+	negate = %s
+	pattern = "%s"
+}
+`
@@ -0,0 +1,81 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIPLoadbalancingRouteTCPBasicCreate(t *testing.T) {
+	serviceName := os.Getenv("OVH_IPLB_SERVICE")
+	name := "test-route-reject"
+	weight := "0"
+	actionType := "reject"
+
+	config := fmt.Sprintf(
+		testAccCheckOvhIpLoadbalancingTcpRouteConfig_basic,
+		serviceName,
+		name,
+		weight,
+		actionType,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccCheckIpLoadbalancingRouteTCPPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIPLoadbalancingRouteTCPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route.testroute", "service_name", serviceName),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route.testroute", "display_name", name),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route.testroute", "weight", weight),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route.testroute", "action.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_tcp_route.testroute", "action.0.type", actionType),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpLoadbalancingRouteTCPPreCheck(t *testing.T) {
+	testAccPreCheckIpLoadbalancing(t)
+	testAccCheckIpLoadbalancingExists(t)
+}
+
+func testAccCheckIPLoadbalancingRouteTCPDestroy(state *terraform.State) error {
+	for _, resource := range state.RootModule().Resources {
+		if resource.Type != "ovh_iploadbalancing_tcp_route" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		endpoint := fmt.Sprintf("/ipLoadbalancing/%s/tcp/route/%s", os.Getenv("OVH_IPLB_SERVICE"), resource.Primary.ID)
+		err := config.OVHClient.Get(endpoint, nil)
+		if err == nil {
+			return fmt.Errorf("IpLoadbalancing tcp route still exists")
+		}
+	}
+	return nil
+}
+
+const testAccCheckOvhIpLoadbalancingTcpRouteConfig_basic = `
+resource "ovh_iploadbalancing_tcp_route" "testroute" {
+	service_name = "%s"
+	display_name = "%s"
+	weight = %s
+
+	action {
+	  type = "%s"
+	}
+}
+`
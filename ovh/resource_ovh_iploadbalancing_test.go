@@ -0,0 +1,57 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpLoadbalancing_basic(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+	name := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckIpLoadbalancingPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingConfig, iplb, name, "modern"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing.iplb", "display_name", name),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing.iplb", "ssl_configuration", "modern"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_iploadbalancing.iplb", "zone.#"),
+					resource.TestCheckResourceAttrSet(
+						"ovh_iploadbalancing.iplb", "ip_loadbalancing"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingConfig, iplb, name+"-updated", "intermediate"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing.iplb", "display_name", name+"-updated"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing.iplb", "ssl_configuration", "intermediate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIpLoadbalancingPreCheck(t *testing.T) {
+	testAccPreCheckIpLoadbalancing(t)
+	testAccCheckIpLoadbalancingExists(t)
+}
+
+const testAccIpLoadbalancingConfig = `
+resource "ovh_iploadbalancing" "iplb" {
+  service_name      = "%s"
+  display_name      = "%s"
+  ssl_configuration = "%s"
+}
+`
@@ -0,0 +1,131 @@
+package ovh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceIpLoadbalancingUdpFarm() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingUdpFarmCreate),
+		Read:          resourceIpLoadbalancingUdpFarmRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingUdpFarmUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingUdpFarmDelete),
+		Importer: &schema.ResourceImporter{
+			State: resourceIpLoadbalancingUdpFarmImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+			},
+			"vrack_network_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIpLoadbalancingUdpFarmImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not service_name/farm id formatted")
+	}
+	serviceName := splitId[0]
+	farmId := splitId[1]
+	d.SetId(farmId)
+	d.Set("service_name", serviceName)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpLoadbalancingUdpFarmCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	farm := (&IpLoadbalancingUdpFarmCreateOrUpdateOpts{}).FromResource(d)
+	service := d.Get("service_name").(string)
+	resp := &IpLoadbalancingFarm{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/farm", service)
+
+	err := config.OVHClient.Post(endpoint, farm, resp)
+	if err != nil {
+		return fmt.Errorf("calling POST %s :\n\t %s", endpoint, err.Error())
+	}
+
+	d.SetId(fmt.Sprintf("%d", resp.FarmId))
+
+	return resourceIpLoadbalancingUdpFarmRead(d, meta)
+}
+
+func resourceIpLoadbalancingUdpFarmRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/farm/%s", service, d.Id())
+	r := &IpLoadbalancingFarm{}
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("display_name", r.DisplayName)
+	d.Set("zone", r.Zone)
+	d.Set("port", r.Port)
+	d.Set("vrack_network_id", r.VrackNetworkId)
+	return nil
+}
+
+func resourceIpLoadbalancingUdpFarmUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/farm/%s", service, d.Id())
+
+	farm := (&IpLoadbalancingUdpFarmCreateOrUpdateOpts{}).FromResource(d)
+
+	err := config.OVHClient.Put(endpoint, farm, nil)
+	if err != nil {
+		return fmt.Errorf("calling %s:\n\t %s", endpoint, err.Error())
+	}
+
+	return resourceIpLoadbalancingUdpFarmRead(d, meta)
+}
+
+func resourceIpLoadbalancingUdpFarmDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	service := d.Get("service_name").(string)
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/farm/%s", service, d.Id())
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("Error calling %s: %s \n", endpoint, err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
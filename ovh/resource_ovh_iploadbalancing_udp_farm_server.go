@@ -0,0 +1,174 @@
+package ovh
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceIpLoadbalancingUdpFarmServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingUdpFarmServerCreate),
+		Read:          resourceIpLoadbalancingUdpFarmServerRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingUdpFarmServerUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingUdpFarmServerDelete),
+		Importer: &schema.ResourceImporter{
+			State: resourceIpLoadbalancingUdpFarmServerImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"farm_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"address": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					ip := v.(string)
+					if net.ParseIP(ip).To4() == nil {
+						errors = append(errors, fmt.Errorf("Address %s is not an IPv4", ip))
+					}
+					return
+				},
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateStringEnum(v.(string), []string{"active", "inactive"})
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+		},
+	}
+}
+
+func resourceIpLoadbalancingUdpFarmServerImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 3)
+	if len(splitId) != 3 {
+		return nil, fmt.Errorf("Import Id is not service_name/farm_id/server id formatted")
+	}
+	serviceName := splitId[0]
+	farmId, err := strconv.Atoi(splitId[1])
+	if err != nil {
+		return nil, fmt.Errorf("Couldn't cast farmId %s to int: %s", splitId[1], err.Error())
+	}
+	serverId := splitId[2]
+
+	d.SetId(serverId)
+	d.Set("farm_id", farmId)
+	d.Set("service_name", serviceName)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpLoadbalancingUdpFarmServerCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	newBackendServer := &IpLoadbalancingUdpFarmServerCreateOpts{
+		DisplayName: helpers.GetNilStringPointerFromData(d, "display_name"),
+		Address:     d.Get("address").(string),
+		Port:        helpers.GetNilIntPointerFromData(d, "port"),
+		Status:      d.Get("status").(string),
+	}
+
+	service := d.Get("service_name").(string)
+	farmid := d.Get("farm_id").(int)
+	r := &IpLoadbalancingUdpFarmServer{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/farm/%d/server", service, farmid)
+
+	err := config.OVHClient.Post(endpoint, newBackendServer, r)
+	if err != nil {
+		return fmt.Errorf("calling POST %s with %d:\n\t %s", endpoint, farmid, err.Error())
+	}
+
+	//set id
+	d.SetId(fmt.Sprintf("%d", r.ServerId))
+
+	return resourceIpLoadbalancingUdpFarmServerRead(d, meta)
+}
+
+func resourceIpLoadbalancingUdpFarmServerRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	service := d.Get("service_name").(string)
+	farmid := d.Get("farm_id").(int)
+	r := &IpLoadbalancingUdpFarmServer{}
+
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/farm/%d/server/%s", service, farmid, d.Id())
+
+	err := config.OVHClient.Get(endpoint, r)
+	if err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	// set resource attributes
+	for k, v := range r.ToMap() {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func resourceIpLoadbalancingUdpFarmServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	update := &IpLoadbalancingUdpFarmServerUpdateOpts{
+		DisplayName: helpers.GetNilStringPointerFromData(d, "display_name"),
+		Port:        helpers.GetNilIntPointerFromData(d, "port"),
+		Status:      helpers.GetNilStringPointerFromData(d, "status"),
+	}
+
+	service := d.Get("service_name").(string)
+	farmid := d.Get("farm_id").(int)
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/farm/%d/server/%s", service, farmid, d.Id())
+	err := config.OVHClient.Put(endpoint, update, nil)
+	if err != nil {
+		return fmt.Errorf("calling PUT %s with %d:\n\t %s", endpoint, farmid, err.Error())
+	}
+
+	return resourceIpLoadbalancingUdpFarmServerRead(d, meta)
+}
+
+func resourceIpLoadbalancingUdpFarmServerDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	service := d.Get("service_name").(string)
+	farmid := d.Get("farm_id").(int)
+
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/farm/%d/server/%s", service, farmid, d.Id())
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling DELETE %s :\n\t %s", endpoint, err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,61 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccIpLoadbalancingUdpFarmServerConfig = `
+data "ovh_iploadbalancing" "iplb" {
+  service_name = "%s"
+}
+
+resource "ovh_iploadbalancing_udp_farm" "testfarm" {
+  service_name = data.ovh_iploadbalancing.iplb.id
+  display_name = "%s"
+  port         = 53
+  zone         = "all"
+}
+
+resource "ovh_iploadbalancing_udp_farm_server" "testfarmserver" {
+  service_name = data.ovh_iploadbalancing.iplb.id
+  farm_id      = ovh_iploadbalancing_udp_farm.testfarm.id
+  display_name = "%s"
+  address      = "10.0.0.11"
+  port         = %d
+  status       = "%s"
+}
+`
+
+func TestAccIpLoadbalancingUdpFarmServerBasic(t *testing.T) {
+	displayName := acctest.RandomWithPrefix(test_prefix)
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpLoadbalancing(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingUdpFarmServerConfig, iplb, displayName, "testBackendA", 53, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_udp_farm_server.testfarmserver", "display_name", "testBackendA"),
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_udp_farm_server.testfarmserver", "address", "10.0.0.11"),
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_udp_farm_server.testfarmserver", "port", "53"),
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_udp_farm_server.testfarmserver", "status", "active"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingUdpFarmServerConfig, iplb, displayName, "testBackendB", 5353, "inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_udp_farm_server.testfarmserver", "display_name", "testBackendB"),
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_udp_farm_server.testfarmserver", "port", "5353"),
+					resource.TestCheckResourceAttr("ovh_iploadbalancing_udp_farm_server.testfarmserver", "status", "inactive"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,51 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccIpLoadbalancingUdpFarmConfig = `
+data "ovh_iploadbalancing" "iplb" {
+  service_name = "%s"
+}
+resource "ovh_iploadbalancing_udp_farm" "testfarm" {
+  service_name = data.ovh_iploadbalancing.iplb.id
+  display_name = "%s"
+  port         = %d
+  zone         = "%s"
+}
+`
+
+func TestAccIpLoadbalancingUdpFarmBasicCreate(t *testing.T) {
+	displayName := acctest.RandomWithPrefix(test_prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpLoadbalancing(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingUdpFarmConfig, os.Getenv("OVH_IPLB_SERVICE"), displayName, 53, "all"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_udp_farm.testfarm", "display_name", displayName),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_udp_farm.testfarm", "port", "53"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_udp_farm.testfarm", "zone", "all"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccIpLoadbalancingUdpFarmConfig, os.Getenv("OVH_IPLB_SERVICE"), displayName, 5353, "all"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_udp_farm.testfarm", "port", "5353"),
+				),
+			},
+		},
+	})
+}
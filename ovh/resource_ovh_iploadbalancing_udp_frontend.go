@@ -0,0 +1,183 @@
+package ovh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+func resourceIpLoadbalancingUdpFrontend() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingUdpFrontendCreate),
+		Read:          resourceIpLoadbalancingUdpFrontendRead,
+		UpdateContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingUdpFrontendUpdate),
+		DeleteContext: ipLoadbalancingAutoRefreshed(resourceIpLoadbalancingUdpFrontendDelete),
+		Importer: &schema.ResourceImporter{
+			State: resourceIpLoadbalancingUdpFrontendImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"dedicated_ipfo": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default_farm_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func resourceIpLoadbalancingUdpFrontendImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "/", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not service_name/frontend id formatted")
+	}
+	serviceName := splitId[0]
+	frontendId := splitId[1]
+	d.SetId(frontendId)
+	d.Set("service_name", serviceName)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceIpLoadbalancingUdpFrontendCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	dedicatedIpFo, _ := helpers.StringsFromSchema(d, "dedicated_ipfo")
+
+	for _, s := range dedicatedIpFo {
+		if err := helpers.ValidateIpBlock(s); err != nil {
+			return fmt.Errorf("Error validating `dedicated_ipfo` value: %s", err)
+		}
+	}
+
+	frontend := &IpLoadbalancingUdpFrontend{
+		Port:          d.Get("port").(string),
+		Zone:          d.Get("zone").(string),
+		DedicatedIpFo: dedicatedIpFo,
+		Disabled:      d.Get("disabled").(bool),
+		DisplayName:   d.Get("display_name").(string),
+	}
+
+	frontend.DefaultFarmId = helpers.GetNilIntPointerFromData(d, "default_farm_id")
+
+	service := d.Get("service_name").(string)
+	resp := &IpLoadbalancingUdpFrontend{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/frontend", service)
+
+	err := config.OVHClient.Post(endpoint, frontend, resp)
+	if err != nil {
+		return fmt.Errorf("calling POST %s:\n\t %s", endpoint, err.Error())
+	}
+
+	d.SetId(fmt.Sprintf("%d", resp.FrontendId))
+
+	return resourceIpLoadbalancingUdpFrontendRead(d, meta)
+}
+
+func resourceIpLoadbalancingUdpFrontendRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	r := &IpLoadbalancingUdpFrontend{}
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/frontend/%s", service, d.Id())
+
+	err := config.OVHClient.Get(endpoint, &r)
+	if err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.SetId(fmt.Sprintf("%d", r.FrontendId))
+
+	dedicatedIpFos := make([]string, 0)
+	dedicatedIpFos = append(dedicatedIpFos, r.DedicatedIpFo...)
+
+	d.Set("dedicated_ipfo", dedicatedIpFos)
+	d.Set("default_farm_id", r.DefaultFarmId)
+	d.Set("disabled", r.Disabled)
+	d.Set("display_name", r.DisplayName)
+	d.Set("port", r.Port)
+	d.Set("zone", r.Zone)
+
+	return nil
+}
+
+func resourceIpLoadbalancingUdpFrontendUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	service := d.Get("service_name").(string)
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/frontend/%s", service, d.Id())
+
+	dedicatedIpFo, _ := helpers.StringsFromSchema(d, "dedicated_ipfo")
+
+	for _, s := range dedicatedIpFo {
+		if err := helpers.ValidateIpBlock(s); err != nil {
+			return fmt.Errorf("Error validating `dedicated_ipfo` value: %s", err)
+		}
+	}
+
+	frontend := &IpLoadbalancingUdpFrontend{
+		Port:          d.Get("port").(string),
+		Zone:          d.Get("zone").(string),
+		DedicatedIpFo: dedicatedIpFo,
+		Disabled:      d.Get("disabled").(bool),
+		DisplayName:   d.Get("display_name").(string),
+	}
+
+	frontend.DefaultFarmId = helpers.GetNilIntPointerFromData(d, "default_farm_id")
+
+	err := config.OVHClient.Put(endpoint, frontend, nil)
+	if err != nil {
+		return fmt.Errorf("calling PUT %s:\n\t %s", endpoint, err.Error())
+	}
+
+	return resourceIpLoadbalancingUdpFrontendRead(d, meta)
+}
+
+func resourceIpLoadbalancingUdpFrontendDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	service := d.Get("service_name").(string)
+	endpoint := fmt.Sprintf("/ipLoadbalancing/%s/udp/frontend/%s", service, d.Id())
+
+	err := config.OVHClient.Delete(endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("calling DELETE %s: %s \n", endpoint, err.Error())
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,99 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIpLoadbalancingUdpFrontend_basic(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpLoadbalancing(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingUdpFrontendConfig_basic, iplb, test_prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_udp_frontend.testfrontend", "display_name", test_prefix),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_udp_frontend.testfrontend", "port", "10053"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_udp_frontend.testfrontend", "disabled", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingUdpFrontendConfig_update, iplb, test_prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_udp_frontend.testfrontend", "display_name", test_prefix),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_udp_frontend.testfrontend", "port", "10053,10054"),
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_udp_frontend.testfrontend", "disabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIpLoadbalancingUdpFrontend_withfarm(t *testing.T) {
+	iplb := os.Getenv("OVH_IPLB_SERVICE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckIpLoadbalancing(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckOvhIpLoadbalancingUdpFrontendConfig_withfarm, iplb, test_prefix, test_prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ovh_iploadbalancing_udp_frontend.testfrontend", "display_name", test_prefix),
+					resource.TestCheckResourceAttrSet(
+						"ovh_iploadbalancing_udp_frontend.testfrontend", "default_farm_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckOvhIpLoadbalancingUdpFrontendConfig_basic = `
+resource "ovh_iploadbalancing_udp_frontend" "testfrontend" {
+  service_name = "%s"
+  display_name = "%s"
+  zone         = "all"
+  port         = "10053"
+  disabled     = true
+}
+`
+
+const testAccCheckOvhIpLoadbalancingUdpFrontendConfig_update = `
+resource "ovh_iploadbalancing_udp_frontend" "testfrontend" {
+  service_name = "%s"
+  display_name = "%s"
+  zone         = "all"
+  port         = "10053,10054"
+  disabled     = false
+}
+`
+
+const testAccCheckOvhIpLoadbalancingUdpFrontendConfig_withfarm = `
+resource "ovh_iploadbalancing_udp_farm" "testfarm" {
+  service_name = "%s"
+  display_name = "%s"
+  zone         = "all"
+  port         = 53
+}
+
+resource "ovh_iploadbalancing_udp_frontend" "testfrontend" {
+  service_name    = ovh_iploadbalancing_udp_farm.testfarm.service_name
+  display_name    = "%s"
+  zone            = "all"
+  port            = "10053"
+  default_farm_id = ovh_iploadbalancing_udp_farm.testfarm.id
+}
+`
@@ -0,0 +1,230 @@
+package ovh
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func resourceVrackIp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVrackIpCreate,
+		Read:   resourceVrackIpRead,
+		Update: resourceVrackIpUpdate,
+		Delete: resourceVrackIpDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVrackIpImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:        schema.TypeString,
+				Description: "The internal name of your vrack",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"block": {
+				Type:             schema.TypeString,
+				Description:      "The IP block to route into the vrack",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: helpers.DiffSuppressIpBlock,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					err := helpers.ValidateIpBlock(v.(string))
+					if err != nil {
+						errors = append(errors, err)
+					}
+					return
+				},
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The zone the IP block is announced in",
+				Optional:    true,
+				Computed:    true,
+			},
+
+			// Computed
+			"gateway": {
+				Type:        schema.TypeString,
+				Description: "The gateway of the IP block in the vrack",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceVrackIpImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	givenId := d.Id()
+	splitId := strings.SplitN(givenId, "|", 2)
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("Import Id is not service_name|block formatted")
+	}
+	serviceName := splitId[0]
+	block := splitId[1]
+	d.SetId(fmt.Sprintf("%s|%s", serviceName, block))
+	d.Set("service_name", serviceName)
+	d.Set("block", block)
+
+	results := make([]*schema.ResourceData, 1)
+	results[0] = d
+	return results, nil
+}
+
+func resourceVrackIpCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	serviceName := d.Get("service_name").(string)
+	opts := (&VrackIpCreateOpts{}).FromResource(d)
+	task := &VrackTask{}
+
+	endpoint := fmt.Sprintf(
+		"/vrack/%s/ip",
+		url.PathEscape(serviceName),
+	)
+
+	if err := config.OVHClient.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForVrackTask(task, config.OVHClient); err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to attach ip block %v: %s", serviceName, opts, err)
+	}
+
+	//set id
+	d.SetId(fmt.Sprintf("%s|%s", serviceName, opts.Block))
+
+	if zone, ok := d.GetOk("zone"); ok {
+		if err := announceVrackIpInZone(serviceName, opts.Block, zone.(string), config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceVrackIpRead(d, meta)
+}
+
+func resourceVrackIpRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	serviceName := d.Get("service_name").(string)
+	block := d.Get("block").(string)
+
+	endpoint := fmt.Sprintf(
+		"/vrack/%s/ip/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(block),
+	)
+
+	vip := &VrackIp{}
+	if err := config.OVHClient.Get(endpoint, vip); err != nil {
+		return helpers.CheckDeleted(d, err, endpoint)
+	}
+
+	d.Set("service_name", vip.Vrack)
+
+	if vip.Zone != nil {
+		d.Set("zone", *vip.Zone)
+	} else {
+		d.Set("zone", "")
+	}
+
+	if vip.Gateway != nil {
+		d.Set("gateway", *vip.Gateway)
+	} else {
+		d.Set("gateway", "")
+	}
+
+	return nil
+}
+
+func resourceVrackIpUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("zone") {
+		serviceName := d.Get("service_name").(string)
+		block := d.Get("block").(string)
+
+		if err := announceVrackIpInZone(serviceName, block, d.Get("zone").(string), config.OVHClient); err != nil {
+			return err
+		}
+	}
+
+	return resourceVrackIpRead(d, meta)
+}
+
+func resourceVrackIpDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	serviceName := d.Get("service_name").(string)
+	block := d.Get("block").(string)
+
+	task := &VrackTask{}
+	endpoint := fmt.Sprintf(
+		"/vrack/%s/ip/%s",
+		url.PathEscape(serviceName),
+		url.PathEscape(block),
+	)
+
+	if err := config.OVHClient.Delete(endpoint, task); err != nil {
+		return fmt.Errorf("Error calling DELETE %s with %s/%s:\n\t %q", endpoint, serviceName, block, err)
+	}
+
+	if err := waitForVrackTask(task, config.OVHClient); err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to detach ip block (%s): %s", serviceName, block, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// announceVrackIpInZone announces an IP block of a vrack in one of
+// its available zones, and waits for the routing to be done.
+func announceVrackIpInZone(serviceName, block, zone string, c *ovh.Client) error {
+	endpoint := fmt.Sprintf(
+		"/vrack/%s/ip/%s/availableZone",
+		url.PathEscape(serviceName),
+		url.PathEscape(block),
+	)
+
+	zones := []string{}
+	if err := c.Get(endpoint, &zones); err != nil {
+		return fmt.Errorf("Error calling GET %s:\n\t %q", endpoint, err)
+	}
+
+	available := false
+	for _, z := range zones {
+		if z == zone {
+			available = true
+			break
+		}
+	}
+
+	if !available {
+		return fmt.Errorf("Zone %s is not available for ip block %s in vrack %s, available zones: %s", zone, block, serviceName, strings.Join(zones, ", "))
+	}
+
+	endpoint = fmt.Sprintf(
+		"/vrack/%s/ip/%s/announceInZone",
+		url.PathEscape(serviceName),
+		url.PathEscape(block),
+	)
+
+	opts := &VrackIpAnnounceInZoneOpts{Zone: zone}
+	task := &VrackTask{}
+	if err := c.Post(endpoint, opts, task); err != nil {
+		return fmt.Errorf("Error calling POST %s with opts %v:\n\t %q", endpoint, opts, err)
+	}
+
+	if err := waitForVrackTask(task, c); err != nil {
+		return fmt.Errorf("Error waiting for vrack (%s) to announce ip block (%s) in zone %s: %s", serviceName, block, zone, err)
+	}
+
+	log.Printf("[DEBUG] IP block %s of vrack %s announced in zone %s", block, serviceName, zone)
+	return nil
+}
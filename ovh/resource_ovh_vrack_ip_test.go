@@ -0,0 +1,39 @@
+package ovh
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var testAccVrackIpConfig = fmt.Sprintf(`
+resource "ovh_vrack_ip" "vip" {
+  service_name = "%s"
+  block        = "%s"
+}
+`, os.Getenv("OVH_VRACK"), os.Getenv("OVH_VRACK_IP_BLOCK"))
+
+func TestAccVrackIp_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccCheckVrackIpPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVrackIpConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ovh_vrack_ip.vip", "service_name", os.Getenv("OVH_VRACK")),
+					resource.TestCheckResourceAttr("ovh_vrack_ip.vip", "block", os.Getenv("OVH_VRACK_IP_BLOCK")),
+					resource.TestCheckResourceAttrSet("ovh_vrack_ip.vip", "gateway"),
+					resource.TestCheckResourceAttrSet("ovh_vrack_ip.vip", "zone"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVrackIpPreCheck(t *testing.T) {
+	testAccPreCheckVRackIp(t)
+	testAccCheckVRackExists(t)
+}
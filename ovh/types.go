@@ -34,3 +34,29 @@ func (v UnitAndValue) ToMap() map[string]interface{} {
 
 	return obj
 }
+
+type ServiceInfos struct {
+	ServiceId             int64              `json:"serviceId"`
+	Status                string             `json:"status"`
+	Creation              string             `json:"creation"`
+	Expiration            string             `json:"expiration"`
+	EngagedUpTo           string             `json:"engagedUpTo"`
+	CanDeleteAtExpiration bool               `json:"canDeleteAtExpiration"`
+	RenewalType           string             `json:"renewalType"`
+	Renew                 *ServiceInfosRenew `json:"renew"`
+	ContactAdmin          string             `json:"contactAdmin"`
+	ContactBilling        string             `json:"contactBilling"`
+	ContactTech           string             `json:"contactTech"`
+}
+
+type ServiceInfosRenew struct {
+	Automatic          bool `json:"automatic"`
+	DeleteAtExpiration bool `json:"deleteAtExpiration"`
+	Forced             bool `json:"forced"`
+	ManualPayment      bool `json:"manualPayment"`
+	Period             *int `json:"period,omitempty"`
+}
+
+type ServiceInfosUpdateOpts struct {
+	Renew *ServiceInfosRenew `json:"renew"`
+}
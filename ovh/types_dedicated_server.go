@@ -154,3 +154,26 @@ func (opts *DedicatedServerInstallTaskDetails) FromResource(d *schema.ResourceDa
 
 	return opts
 }
+
+type DedicatedServerVirtualMac struct {
+	MacAddress string `json:"macAddress"`
+	Type       string `json:"type"`
+}
+
+type DedicatedServerVirtualMacCreateOpts struct {
+	IpAddress          string `json:"ipAddress"`
+	Type               string `json:"type"`
+	VirtualMachineName string `json:"virtualMachineName"`
+}
+
+type DedicatedServerVirtualAddress struct {
+	IpAddress          string `json:"ipAddress"`
+	VirtualMachineName string `json:"virtualMachineName"`
+}
+
+func (a DedicatedServerVirtualAddress) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["ip_address"] = a.IpAddress
+	obj["virtual_machine_name"] = a.VirtualMachineName
+	return obj
+}
@@ -0,0 +1,280 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+type DomainTask struct {
+	Id           int64     `json:"id"`
+	Function     string    `json:"function"`
+	Comment      string    `json:"comment"`
+	Status       string    `json:"status"`
+	CreationDate time.Time `json:"creationDate"`
+	LastUpdate   time.Time `json:"lastUpdate"`
+	TodoDate     time.Time `json:"todoDate"`
+	DoneDate     time.Time `json:"doneDate"`
+}
+
+type DomainZoneDnssec struct {
+	Status string `json:"status"`
+}
+
+type DomainDsRecord struct {
+	Id        int64  `json:"id,omitempty"`
+	Algorithm int    `json:"algorithm"`
+	Flags     int    `json:"flags"`
+	PublicKey string `json:"publicKey"`
+	Tag       int    `json:"tag"`
+	Status    string `json:"status,omitempty"`
+}
+
+func (r DomainDsRecord) String() string {
+	return fmt.Sprintf(
+		"dsRecord[id: %v, algorithm: %v, flags: %v, tag: %v]",
+		r.Id,
+		r.Algorithm,
+		r.Flags,
+		r.Tag,
+	)
+}
+
+func (r DomainDsRecord) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["algorithm"] = r.Algorithm
+	obj["flags"] = r.Flags
+	obj["public_key"] = r.PublicKey
+	obj["tag"] = r.Tag
+
+	return obj
+}
+
+type DomainDsRecordKey struct {
+	Algorithm int    `json:"algorithm"`
+	Flags     int    `json:"flags"`
+	PublicKey string `json:"publicKey"`
+	Tag       int    `json:"tag"`
+}
+
+func (opts *DomainDsRecordKey) FromMap(data map[string]interface{}) *DomainDsRecordKey {
+	opts.Algorithm = data["algorithm"].(int)
+	opts.Flags = data["flags"].(int)
+	opts.PublicKey = data["public_key"].(string)
+	opts.Tag = data["tag"].(int)
+	return opts
+}
+
+type DomainDsRecordsUpdateOpts struct {
+	Keys []DomainDsRecordKey `json:"keys"`
+}
+
+func (opts *DomainDsRecordsUpdateOpts) FromResource(d *schema.ResourceData) *DomainDsRecordsUpdateOpts {
+	opts.Keys = []DomainDsRecordKey{}
+
+	for _, v := range d.Get("ds_records").(*schema.Set).List() {
+		opts.Keys = append(opts.Keys, *(&DomainDsRecordKey{}).FromMap(v.(map[string]interface{})))
+	}
+
+	return opts
+}
+
+type DomainZoneSoa struct {
+	Email       string `json:"email"`
+	Expire      int    `json:"expire"`
+	NxDomainTtl int    `json:"nxDomainTtl"`
+	Refresh     int    `json:"refresh"`
+	Retry       int    `json:"retry"`
+	Serial      int64  `json:"serial"`
+	Server      string `json:"server"`
+	Ttl         int    `json:"ttl"`
+}
+
+func (s DomainZoneSoa) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["email"] = s.Email
+	obj["expire"] = s.Expire
+	obj["nx_domain_ttl"] = s.NxDomainTtl
+	obj["refresh"] = s.Refresh
+	obj["retry"] = s.Retry
+	obj["serial"] = s.Serial
+	obj["server"] = s.Server
+	obj["ttl"] = s.Ttl
+
+	return obj
+}
+
+type DomainZoneSoaUpdateOpts struct {
+	Email       *string `json:"email,omitempty"`
+	Expire      *int    `json:"expire,omitempty"`
+	NxDomainTtl *int    `json:"nxDomainTtl,omitempty"`
+	Refresh     *int    `json:"refresh,omitempty"`
+	Retry       *int    `json:"retry,omitempty"`
+	Ttl         *int    `json:"ttl,omitempty"`
+}
+
+func (opts *DomainZoneSoaUpdateOpts) FromResource(d *schema.ResourceData) *DomainZoneSoaUpdateOpts {
+	opts.Email = helpers.GetNilStringPointerFromData(d, "email")
+	opts.Expire = helpers.GetNilIntPointerFromData(d, "expire")
+	opts.NxDomainTtl = helpers.GetNilIntPointerFromData(d, "nx_domain_ttl")
+	opts.Refresh = helpers.GetNilIntPointerFromData(d, "refresh")
+	opts.Retry = helpers.GetNilIntPointerFromData(d, "retry")
+	opts.Ttl = helpers.GetNilIntPointerFromData(d, "ttl")
+	return opts
+}
+
+type Domain struct {
+	Domain                     string `json:"domain"`
+	DnssecSupported            bool   `json:"dnssecSupported"`
+	GlueRecordIpv6Supported    bool   `json:"glueRecordIpv6Supported"`
+	GlueRecordMultiIpSupported bool   `json:"glueRecordMultiIpSupported"`
+	LastUpdate                 string `json:"lastUpdate"`
+	NameServerType             string `json:"nameServerType"`
+	Offer                      string `json:"offer"`
+	OwoSupported               bool   `json:"owoSupported"`
+	TransferLockStatus         string `json:"transferLockStatus"`
+	WhoisOwner                 string `json:"whoisOwner"`
+}
+
+type DomainUpdateOpts struct {
+	NameServerType     *string `json:"nameServerType,omitempty"`
+	TransferLockStatus *string `json:"transferLockStatus,omitempty"`
+}
+
+type DomainNameServer struct {
+	Id       int64   `json:"id"`
+	Host     string  `json:"host"`
+	Ip       *string `json:"ip"`
+	IsUsed   bool    `json:"isUsed"`
+	ToDelete bool    `json:"toDelete"`
+}
+
+func (ns DomainNameServer) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["host"] = ns.Host
+	if ns.Ip != nil {
+		obj["ip"] = *ns.Ip
+	}
+
+	return obj
+}
+
+type DomainNameServerOpts struct {
+	Host string  `json:"host"`
+	Ip   *string `json:"ip,omitempty"`
+}
+
+func (opts *DomainNameServerOpts) FromMap(data map[string]interface{}) *DomainNameServerOpts {
+	opts.Host = data["host"].(string)
+	opts.Ip = helpers.GetNilStringPointerFromData(data, "ip")
+	return opts
+}
+
+type DomainNameServersUpdateOpts struct {
+	NameServers []DomainNameServerOpts `json:"nameServers"`
+}
+
+func (opts *DomainNameServersUpdateOpts) FromResource(d *schema.ResourceData) *DomainNameServersUpdateOpts {
+	opts.NameServers = []DomainNameServerOpts{}
+
+	for _, v := range d.Get("servers").(*schema.Set).List() {
+		opts.NameServers = append(opts.NameServers, *(&DomainNameServerOpts{}).FromMap(v.(map[string]interface{})))
+	}
+
+	return opts
+}
+
+type DomainGlueRecord struct {
+	Host string   `json:"host"`
+	Ips  []string `json:"ips"`
+}
+
+type DomainGlueRecordCreateOpts struct {
+	Host string   `json:"host"`
+	Ips  []string `json:"ips"`
+}
+
+func (opts *DomainGlueRecordCreateOpts) FromResource(d *schema.ResourceData) (*DomainGlueRecordCreateOpts, error) {
+	ips, err := helpers.StringsFromSchema(d, "ips")
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Host = d.Get("host").(string)
+	opts.Ips = ips
+	return opts, nil
+}
+
+type DomainGlueRecordUpdateOpts struct {
+	Ips []string `json:"ips"`
+}
+
+func (opts *DomainGlueRecordUpdateOpts) FromResource(d *schema.ResourceData) (*DomainGlueRecordUpdateOpts, error) {
+	ips, err := helpers.StringsFromSchema(d, "ips")
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Ips = ips
+	return opts, nil
+}
+
+type DomainZoneDynHostRecord struct {
+	Id        int64  `json:"id"`
+	Ip        string `json:"ip"`
+	SubDomain string `json:"subDomain"`
+	Ttl       int    `json:"ttl"`
+	Zone      string `json:"zone"`
+}
+
+type DomainZoneDynHostRecordOpts struct {
+	Ip        string `json:"ip"`
+	SubDomain string `json:"subDomain"`
+}
+
+func (opts *DomainZoneDynHostRecordOpts) FromResource(d *schema.ResourceData) *DomainZoneDynHostRecordOpts {
+	opts.Ip = d.Get("ip").(string)
+	opts.SubDomain = d.Get("subdomain").(string)
+	return opts
+}
+
+type DomainZoneDynHostLogin struct {
+	Login     string `json:"login"`
+	SubDomain string `json:"subDomain"`
+	Zone      string `json:"zone"`
+}
+
+type DomainZoneDynHostLoginCreateOpts struct {
+	LoginSuffix string `json:"loginSuffix"`
+	Password    string `json:"password"`
+	SubDomain   string `json:"subDomain"`
+}
+
+func (opts *DomainZoneDynHostLoginCreateOpts) FromResource(d *schema.ResourceData) *DomainZoneDynHostLoginCreateOpts {
+	opts.LoginSuffix = d.Get("login_suffix").(string)
+	opts.Password = d.Get("password").(string)
+	opts.SubDomain = d.Get("subdomain").(string)
+	return opts
+}
+
+type DomainZoneDynHostLoginUpdateOpts struct {
+	SubDomain string `json:"subDomain"`
+}
+
+type DomainZoneDynHostLoginPasswordOpts struct {
+	Password string `json:"password"`
+}
+
+type DomainZoneRestorePoint struct {
+	CreationDate string `json:"creationDate"`
+	ZoneFileUrl  string `json:"zoneFileUrl"`
+}
+
+func (p DomainZoneRestorePoint) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["creation_date"] = p.CreationDate
+	obj["zone_file_url"] = p.ZoneFileUrl
+	return obj
+}
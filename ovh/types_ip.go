@@ -0,0 +1,247 @@
+package ovh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ovh/ovh/helpers"
+)
+
+type IpRoutedTo struct {
+	ServiceName string `json:"serviceName"`
+}
+
+type Ip struct {
+	Ip              string      `json:"ip"`
+	Description     string      `json:"description"`
+	Type            string      `json:"type"`
+	Country         string      `json:"country"`
+	Campus          string      `json:"campus"`
+	OrganisationId  string      `json:"organisationId"`
+	CanBeTerminated bool        `json:"canBeTerminated"`
+	RoutedTo        *IpRoutedTo `json:"routedTo"`
+}
+
+func (ip Ip) String() string {
+	return fmt.Sprintf(
+		"ip: %v, type: %v, description: %v",
+		ip.Ip,
+		ip.Type,
+		ip.Description,
+	)
+}
+
+func (ip Ip) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["ip"] = ip.Ip
+	obj["description"] = ip.Description
+	obj["type"] = ip.Type
+	obj["country"] = ip.Country
+	obj["campus"] = ip.Campus
+	obj["organisation_id"] = ip.OrganisationId
+	obj["can_be_terminated"] = ip.CanBeTerminated
+
+	if ip.RoutedTo != nil {
+		obj["routed_to_service_name"] = ip.RoutedTo.ServiceName
+	} else {
+		obj["routed_to_service_name"] = ""
+	}
+
+	return obj
+}
+
+type IpTask struct {
+	Id         int64     `json:"taskId"`
+	Function   string    `json:"function"`
+	Comment    string    `json:"comment"`
+	Status     string    `json:"status"`
+	LastUpdate time.Time `json:"lastUpdate"`
+	DoneDate   time.Time `json:"doneDate"`
+	StartDate  time.Time `json:"startDate"`
+}
+
+type IpMoveOpts struct {
+	To      string  `json:"to"`
+	Nexthop *string `json:"nexthop,omitempty"`
+}
+
+type IpFirewall struct {
+	IpOnFirewall string `json:"ipOnFirewall"`
+	Enabled      bool   `json:"enabled"`
+	State        string `json:"state"`
+}
+
+type IpFirewallCreateOpts struct {
+	IpOnFirewall string `json:"ipOnFirewall"`
+}
+
+type IpFirewallUpdateOpts struct {
+	Enabled bool `json:"enabled"`
+}
+
+type IpFirewallRule struct {
+	Sequence        int    `json:"sequence"`
+	Action          string `json:"action"`
+	Protocol        string `json:"protocol"`
+	Source          string `json:"source"`
+	SourcePort      string `json:"sourcePort"`
+	Destination     string `json:"destination"`
+	DestinationPort string `json:"destinationPort"`
+	TcpOption       string `json:"tcpOption"`
+	Fragments       bool   `json:"fragments"`
+	Rule            string `json:"rule"`
+	State           string `json:"state"`
+	CreationDate    string `json:"creationDate"`
+}
+
+func (r IpFirewallRule) String() string {
+	return fmt.Sprintf(
+		"sequence: %v, action: %v, protocol: %v, state: %v",
+		r.Sequence,
+		r.Action,
+		r.Protocol,
+		r.State,
+	)
+}
+
+type IpFirewallRuleCreateOpts struct {
+	Sequence        int                          `json:"sequence"`
+	Action          string                       `json:"action"`
+	Protocol        string                       `json:"protocol"`
+	Source          *string                      `json:"source,omitempty"`
+	SourcePort      *int                         `json:"sourcePort,omitempty"`
+	DestinationPort *int                         `json:"destinationPort,omitempty"`
+	TcpOption       *IpFirewallRuleTcpOptionOpts `json:"tcpOption,omitempty"`
+}
+
+type IpFirewallRuleTcpOptionOpts struct {
+	Option    *string `json:"option,omitempty"`
+	Fragments *bool   `json:"fragments,omitempty"`
+}
+
+func (opts *IpFirewallRuleCreateOpts) FromResource(d *schema.ResourceData) *IpFirewallRuleCreateOpts {
+	opts.Sequence = d.Get("sequence").(int)
+	opts.Action = d.Get("action").(string)
+	opts.Protocol = d.Get("protocol").(string)
+	opts.Source = helpers.GetNilStringPointerFromData(d, "source")
+	opts.SourcePort = helpers.GetNilIntPointerFromData(d, "source_port")
+	opts.DestinationPort = helpers.GetNilIntPointerFromData(d, "destination_port")
+
+	tcpOption := helpers.GetNilStringPointerFromData(d, "tcp_option")
+	fragments := helpers.GetNilBoolPointerFromData(d, "fragments")
+	if tcpOption != nil || fragments != nil {
+		opts.TcpOption = &IpFirewallRuleTcpOptionOpts{
+			Option:    tcpOption,
+			Fragments: fragments,
+		}
+	}
+
+	return opts
+}
+
+type IpMitigation struct {
+	IpOnMitigation string `json:"ipOnMitigation"`
+	Permanent      bool   `json:"permanent"`
+	Auto           bool   `json:"auto"`
+	State          string `json:"state"`
+}
+
+type IpMitigationCreateOpts struct {
+	IpOnMitigation string `json:"ipOnMitigation"`
+}
+
+type IpMitigationUpdateOpts struct {
+	Permanent bool `json:"permanent"`
+}
+
+type IpMitigationTraffic struct {
+	Bps int64 `json:"bps"`
+	Pps int64 `json:"pps"`
+}
+
+type IpMitigationStats struct {
+	Timestamp int64                `json:"timestamp"`
+	In        *IpMitigationTraffic `json:"in"`
+	Out       *IpMitigationTraffic `json:"out"`
+}
+
+func (s IpMitigationStats) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["timestamp"] = s.Timestamp
+
+	if s.In != nil {
+		obj["in_bps"] = s.In.Bps
+		obj["in_pps"] = s.In.Pps
+	}
+
+	if s.Out != nil {
+		obj["out_bps"] = s.Out.Bps
+		obj["out_pps"] = s.Out.Pps
+	}
+
+	return obj
+}
+
+type IpMitigationProfile struct {
+	IpMitigationProfile   string `json:"ipMitigationProfile"`
+	AutoMitigationTimeOut int    `json:"autoMitigationTimeOut"`
+	State                 string `json:"state"`
+}
+
+type IpMitigationProfileCreateOpts struct {
+	IpMitigationProfile   string `json:"ipMitigationProfile"`
+	AutoMitigationTimeOut int    `json:"autoMitigationTimeOut"`
+}
+
+type IpMitigationProfileUpdateOpts struct {
+	AutoMitigationTimeOut int `json:"autoMitigationTimeOut"`
+}
+
+type IpReverse struct {
+	IpReverse string `json:"ipReverse"`
+	Reverse   string `json:"reverse"`
+}
+
+func (r IpReverse) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["ipreverse"] = r.IpReverse
+	obj["reverse"] = r.Reverse
+	return obj
+}
+
+type IpSpam struct {
+	IpSpamming string `json:"ipSpamming"`
+	State      string `json:"state"`
+	Date       string `json:"date"`
+	Time       int64  `json:"time"`
+}
+
+func (s IpSpam) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["ip"] = s.IpSpamming
+	obj["state"] = s.State
+	obj["date"] = s.Date
+	obj["time"] = s.Time
+	return obj
+}
+
+type IpAntihack struct {
+	IpBlocked string `json:"ipBlocked"`
+	State     string `json:"state"`
+	Time      int64  `json:"time"`
+	Logs      string `json:"logs"`
+}
+
+func (a IpAntihack) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["ip"] = a.IpBlocked
+	obj["state"] = a.State
+	obj["time"] = a.Time
+	obj["logs"] = a.Logs
+	return obj
+}
+
+type IpUpdateOpts struct {
+	Description *string `json:"description"`
+}
@@ -23,6 +23,16 @@ type IpLoadbalancing struct {
 	DisplayName      string                          `json:"displayName"`
 }
 
+type IpLoadbalancingUpdateOpts struct {
+	DisplayName      *string `json:"displayName,omitempty"`
+	SslConfiguration *string `json:"sslConfiguration,omitempty"`
+}
+
+type IpLoadbalancingZone struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
 type IpLoadbalancingOrderableZone struct {
 	Name     string `json:"name"`
 	PlanCode string `json:"plan_code"`
@@ -43,8 +53,19 @@ type IPLoadbalancingRefreshPending struct {
 	Zone   string `json:"zone"`
 }
 
+func (v IPLoadbalancingRefreshPending) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["number"] = v.Number
+	obj["zone"] = v.Zone
+	return obj
+}
+
 type IPLoadbalancingRefreshPendings []IPLoadbalancingRefreshPending
 
+type IpLoadbalancingRefreshOpts struct {
+	Zone string `json:"zone"`
+}
+
 type IpLoadbalancingFarmCreateOrUpdateOpts struct {
 	Balance        *string                          `json:"balance,omitempty"`
 	DisplayName    *string                          `json:"displayName,omitempty"`
@@ -173,6 +194,19 @@ type IPLoadbalancingRouteHTTP struct {
 	FrontendID  int                             `json:"frontendId,omitempty"`  //Route traffic for this frontend
 }
 
+// IPLoadbalancingRouteFrontendOpts sets the frontend of a route, a nil
+// frontend detaches the route from its frontend
+type IPLoadbalancingRouteFrontendOpts struct {
+	FrontendID *int `json:"frontendId"`
+}
+
+// IPLoadbalancingRouteTCPAction Action triggered when all rules match.
+// TCP actions have the same shape as HTTP ones, without status
+type IPLoadbalancingRouteTCPAction = IPLoadbalancingRouteHTTPAction
+
+// IPLoadbalancingRouteTCP TCP Route
+type IPLoadbalancingRouteTCP = IPLoadbalancingRouteHTTP
+
 type IpLoadbalancingTcpFrontend struct {
 	FrontendId    int      `json:"frontendId,omitempty"`
 	Port          string   `json:"port"`
@@ -211,6 +245,47 @@ type IPLoadbalancingRouteHTTPRule struct {
 	SubField    string `json:"subField,omitempty"`    //Name of sub-field, if applicable. This may be a Cookie or Header name for instance
 }
 
+// IPLoadbalancingRouteHTTPRuleUpdateOpts update opts of a HTTP route rule,
+// optional fields are sent as null to be cleared
+type IPLoadbalancingRouteHTTPRuleUpdateOpts struct {
+	DisplayName *string `json:"displayName"`
+	Field       *string `json:"field"`
+	Match       *string `json:"match"`
+	Negate      *bool   `json:"negate"`
+	Pattern     *string `json:"pattern"`
+	SubField    *string `json:"subField"`
+}
+
+func (opts *IPLoadbalancingRouteHTTPRuleUpdateOpts) FromRule(rule *IPLoadbalancingRouteHTTPRule) *IPLoadbalancingRouteHTTPRuleUpdateOpts {
+	opts.DisplayName = helpers.GetNilStringPointer(rule.DisplayName)
+	opts.Field = &rule.Field
+	opts.Match = &rule.Match
+	opts.Negate = &rule.Negate
+	opts.Pattern = helpers.GetNilStringPointer(rule.Pattern)
+	opts.SubField = helpers.GetNilStringPointer(rule.SubField)
+	return opts
+}
+
+// IpLoadbalancingRouteAvailableAction action available for the routes of a protocol
+type IpLoadbalancingRouteAvailableAction struct {
+	Destination *string `json:"destination"`
+	Name        string  `json:"name"`
+	Status      []int   `json:"status"`
+	Type        string  `json:"type"`
+}
+
+// IpLoadbalancingRouteAvailableRule rule available for the routes of a protocol
+type IpLoadbalancingRouteAvailableRule struct {
+	HasSubField bool     `json:"hasSubField"`
+	Matches     []string `json:"matches"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Values      []string `json:"values"`
+}
+
+// IPLoadbalancingRouteTCPRule TCP Route Rule
+type IPLoadbalancingRouteTCPRule = IPLoadbalancingRouteHTTPRule
+
 type IpLoadbalancingFarmServerCreateOpts struct {
 	Address              string  `json:"address"`
 	Backup               *bool   `json:"backup"`
@@ -355,3 +430,123 @@ func (opts *IpLoadbalancingVrackNetworkUpdateOpts) FromResource(d *schema.Resour
 
 	return opts
 }
+
+type IpLoadbalancingSsl struct {
+	Id          int      `json:"id"`
+	DisplayName *string  `json:"displayName"`
+	ExpireDate  string   `json:"expireDate"`
+	Fingerprint string   `json:"fingerprint"`
+	San         []string `json:"san"`
+	Serial      string   `json:"serial"`
+	Subject     string   `json:"subject"`
+	Type        string   `json:"type"`
+}
+
+func (v IpLoadbalancingSsl) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+	obj["expire_date"] = v.ExpireDate
+	obj["fingerprint"] = v.Fingerprint
+	obj["san"] = v.San
+	obj["serial"] = v.Serial
+	obj["subject"] = v.Subject
+	obj["type"] = v.Type
+
+	if v.DisplayName != nil {
+		obj["display_name"] = *v.DisplayName
+	}
+
+	return obj
+}
+
+type IpLoadbalancingSslCreateOpts struct {
+	Certificate string  `json:"certificate"`
+	Chain       *string `json:"chain,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	Key         string  `json:"key"`
+}
+
+func (opts *IpLoadbalancingSslCreateOpts) FromResource(d *schema.ResourceData) *IpLoadbalancingSslCreateOpts {
+	opts.Certificate = d.Get("certificate").(string)
+	opts.Chain = helpers.GetNilStringPointerFromData(d, "chain")
+	opts.DisplayName = helpers.GetNilStringPointerFromData(d, "display_name")
+	opts.Key = d.Get("key").(string)
+	return opts
+}
+
+type IpLoadbalancingSslUpdateOpts struct {
+	DisplayName *string `json:"displayName"`
+}
+
+func (opts *IpLoadbalancingSslUpdateOpts) FromResource(d *schema.ResourceData) *IpLoadbalancingSslUpdateOpts {
+	opts.DisplayName = helpers.GetNilStringPointerFromData(d, "display_name")
+	return opts
+}
+
+type IpLoadbalancingFreeCertificateOpts struct {
+	Fqdn []string `json:"fqdn"`
+}
+
+type IpLoadbalancingUdpFrontend struct {
+	FrontendId    int      `json:"frontendId,omitempty"`
+	Port          string   `json:"port"`
+	Zone          string   `json:"zone"`
+	DedicatedIpFo []string `json:"dedicatedIpfo"`
+	DefaultFarmId *int     `json:"defaultFarmId,omitempty"`
+	Disabled      bool     `json:"disabled"`
+	DisplayName   string   `json:"displayName"`
+}
+
+type IpLoadbalancingUdpFarmCreateOrUpdateOpts struct {
+	DisplayName    *string `json:"displayName,omitempty"`
+	Port           *int    `json:"port,omitempty"`
+	VrackNetworkId *int64  `json:"vrackNetworkId,omitempty"`
+	Zone           string  `json:"zone"`
+}
+
+func (opts *IpLoadbalancingUdpFarmCreateOrUpdateOpts) FromResource(d *schema.ResourceData) *IpLoadbalancingUdpFarmCreateOrUpdateOpts {
+	opts.DisplayName = helpers.GetNilStringPointerFromData(d, "display_name")
+	opts.Port = helpers.GetNilIntPointerFromData(d, "port")
+	opts.VrackNetworkId = helpers.GetNilInt64PointerFromData(d, "vrack_network_id")
+	opts.Zone = d.Get("zone").(string)
+	return opts
+}
+
+type IpLoadbalancingUdpFarmServerCreateOpts struct {
+	Address     string  `json:"address"`
+	DisplayName *string `json:"displayName,omitempty"`
+	Port        *int    `json:"port,omitempty"`
+	Status      string  `json:"status"`
+}
+
+type IpLoadbalancingUdpFarmServerUpdateOpts struct {
+	DisplayName *string `json:"displayName"`
+	Port        *int    `json:"port,omitempty"`
+	Status      *string `json:"status"`
+}
+
+type IpLoadbalancingUdpFarmServer struct {
+	Address     string  `json:"address"`
+	DisplayName *string `json:"displayName"`
+	FarmId      int     `json:"farmId"`
+	Port        *int    `json:"port"`
+	ServerId    int     `json:"serverId"`
+	Status      string  `json:"status"`
+}
+
+func (v IpLoadbalancingUdpFarmServer) ToMap() map[string]interface{} {
+	obj := make(map[string]interface{})
+
+	obj["address"] = v.Address
+	obj["farm_id"] = v.FarmId
+	obj["status"] = v.Status
+
+	if v.DisplayName != nil {
+		obj["display_name"] = *v.DisplayName
+	}
+
+	if v.Port != nil {
+		obj["port"] = *v.Port
+	}
+
+	return obj
+}
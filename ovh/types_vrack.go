@@ -62,6 +62,26 @@ func (opts *VrackIpLoadbalancingCreateOpts) FromResource(d *schema.ResourceData)
 	return opts
 }
 
+type VrackIp struct {
+	Vrack   string  `json:"vrack"`
+	Ip      string  `json:"ip"`
+	Gateway *string `json:"gateway"`
+	Zone    *string `json:"zone"`
+}
+
+type VrackIpCreateOpts struct {
+	Block string `json:"block"`
+}
+
+func (opts *VrackIpCreateOpts) FromResource(d *schema.ResourceData) *VrackIpCreateOpts {
+	opts.Block = d.Get("block").(string)
+	return opts
+}
+
+type VrackIpAnnounceInZoneOpts struct {
+	Zone string `json:"zone"`
+}
+
 type VrackTask struct {
 	Id           int       `json:"id"`
 	Function     string    `json:"function"`